@@ -0,0 +1,18 @@
+//go:build darwin || freebsd || netbsd
+
+package cmd
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// fileBirthTime returns when a file was created, if the filesystem records it
+func fileBirthTime(path string, info os.FileInfo) (time.Time, bool) {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || stat.Birthtimespec.Sec <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(stat.Birthtimespec.Unix()), true
+}
@@ -0,0 +1,13 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !windows
+
+package cmd
+
+import (
+	"os"
+	"time"
+)
+
+// fileBirthTime returns when a file was created; this system doesn't say
+func fileBirthTime(path string, info os.FileInfo) (time.Time, bool) {
+	return time.Time{}, false
+}
@@ -0,0 +1,18 @@
+//go:build windows
+
+package cmd
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// fileBirthTime returns when a file was created, if the filesystem records it
+func fileBirthTime(path string, info os.FileInfo) (time.Time, bool) {
+	data, ok := info.Sys().(*syscall.Win32FileAttributeData)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(0, data.CreationTime.Nanoseconds()), true
+}
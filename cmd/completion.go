@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	// completeTagRe matches a #tag being typed at the cursor
+	completeTagRe = regexp.MustCompile(`(?:^|[^#\w])#([\w/-]*)$`)
+	// completeLinkRe matches a [[note link being typed at the cursor
+	completeLinkRe = regexp.MustCompile(`\[\[([^\[\]]*)$`)
+)
+
+// maxCompletions is how many suggestions the editor shows at once
+const maxCompletions = 6
+
+// completion is a suggestion offered while typing in the editor
+type completion struct {
+	value string // what replaces the typed prefix
+	label string // how the suggestion is shown
+}
+
+// rankedTags returns every tag in use, most frequent first
+func rankedTags() []string {
+	tagCounts, _ := getAllTags()
+
+	var tags []string
+	for tag := range tagCounts {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tagCounts[tags[i]] != tagCounts[tags[j]] {
+			return tagCounts[tags[i]] > tagCounts[tags[j]]
+		}
+		return tags[i] < tags[j]
+	})
+	return tags
+}
+
+// noteNames returns the name of every note, notebook included, without .md
+func noteNames() []string {
+	var names []string
+	journals := loadJournalConfigs()
+	for _, file := range noteFiles("") {
+		names = append(names, noteLinkName(journals, file))
+	}
+	return names
+}
+
+// tagCompletions suggests tags starting with prefix, keeping their ranking
+func tagCompletions(tags []string, prefix string) []completion {
+	prefix = strings.ToLower(prefix)
+
+	var matches []completion
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, prefix) && tag != prefix {
+			matches = append(matches, completion{value: tag, label: "#" + tag})
+		}
+		if len(matches) == maxCompletions {
+			break
+		}
+	}
+	return matches
+}
+
+// linkCompletions suggests notes whose name contains query, with names
+// starting with it first
+func linkCompletions(names []string, query string) []completion {
+	query = strings.ToLower(query)
+
+	var starts, contains []completion
+	for _, name := range names {
+		lower := strings.ToLower(name)
+		switch {
+		case strings.HasPrefix(lower, query):
+			starts = append(starts, completion{value: name, label: "[[" + name + "]]"})
+		case strings.Contains(lower, query):
+			contains = append(contains, completion{value: name, label: "[[" + name + "]]"})
+		}
+	}
+
+	matches := append(starts, contains...)
+	if len(matches) > maxCompletions {
+		matches = matches[:maxCompletions]
+	}
+	return matches
+}
+
+// editorTextBeforeCursor returns the current line of the editor up to the cursor
+func (m model) editorTextBeforeCursor() string {
+	lines := strings.Split(m.editor.Value(), "\n")
+	row := m.editor.Line()
+	if row >= len(lines) {
+		return ""
+	}
+
+	info := m.editor.LineInfo()
+	line := []rune(lines[row])
+	col := min(info.StartColumn+info.ColumnOffset, len(line))
+	return string(line[:col])
+}
+
+// updateCompletions works out the suggestions for what is being typed.
+// Tags and note names are read once per editing session.
+func (m model) updateCompletions() model {
+	before := m.editorTextBeforeCursor()
+	m.completions = nil
+
+	if match := completeLinkRe.FindStringSubmatch(before); match != nil {
+		if m.noteVocab == nil {
+			m.noteVocab = noteNames()
+		}
+		m.completeFrom = match[1]
+		m.completeLink = true
+		m.completions = linkCompletions(m.noteVocab, match[1])
+	} else if match := completeTagRe.FindStringSubmatch(before); match != nil {
+		if m.tagVocab == nil {
+			m.tagVocab = rankedTags()
+		}
+		m.completeFrom = match[1]
+		m.completeLink = false
+		m.completions = tagCompletions(m.tagVocab, match[1])
+	}
+
+	if m.completeIdx >= len(m.completions) {
+		m.completeIdx = 0
+	}
+	return m
+}
+
+// acceptCompletion replaces the typed prefix with the chosen suggestion
+func (m model) acceptCompletion() model {
+	if len(m.completions) == 0 {
+		return m
+	}
+	choice := m.completions[m.completeIdx]
+
+	for range []rune(m.completeFrom) {
+		m.editor, _ = m.editor.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	m.editor.InsertString(choice.value)
+	if m.completeLink {
+		m.editor.InsertString("]]")
+	}
+
+	m.completions = nil
+	m.completeIdx = 0
+	return m
+}
+
+// resetCompletions closes the menu and forgets the cached tags and notes
+func (m model) resetCompletions() model {
+	m.completions = nil
+	m.completeIdx = 0
+	m.tagVocab = nil
+	m.noteVocab = nil
+	return m
+}
+
+func (m model) renderCompletions() string {
+	if len(m.completions) == 0 {
+		return ""
+	}
+
+	var items []string
+	for i, c := range m.completions {
+		if i == m.completeIdx {
+			items = append(items, m.styles.SelectedItem.Render(c.label))
+		} else {
+			items = append(items, m.styles.NormalItem.Render(c.label))
+		}
+	}
+
+	hint := m.styles.MutedText.Render(fmt.Sprintf("Tab accept • Ctrl+N/Ctrl+P choose • Esc close (%d/%d)", m.completeIdx+1, len(m.completions)))
+	return lipgloss.JoinVertical(lipgloss.Left, lipgloss.JoinHorizontal(lipgloss.Top, items...), hint)
+}
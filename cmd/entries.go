@@ -0,0 +1,339 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// Matches the "### HH:MM" headings createTodayEntry writes for each block
+var entryHeadingRe = regexp.MustCompile(`^###\s+(\d{1,2}:\d{2})\s*$`)
+
+// journalEntry is a single timestamped block inside a daily journal file
+type journalEntry struct {
+	date  string // YYYY-MM-DD of the day the block belongs to
+	time  string // HH:MM from the block heading
+	index int    // 1-based position of the block within the day
+	body  string
+	tags  []string
+	start int // line index of the block heading
+	end   int // line index one past the last line of the block
+}
+
+// parseJournalEntries splits a daily journal into its timestamped blocks
+func parseJournalEntries(date, content string) []journalEntry {
+	lines := strings.Split(content, "\n")
+
+	var entries []journalEntry
+	var fence codeFence
+	current := -1
+
+	closeCurrent := func(end int) {
+		if current < 0 {
+			return
+		}
+		entry := &entries[current]
+		entry.end = end
+		entry.body = strings.TrimSpace(strings.Join(lines[entry.start+1:end], "\n"))
+		entry.tags = extractTags(entry.body)
+		current = -1
+	}
+
+	for i, line := range lines {
+		if fence.step(line) {
+			continue
+		}
+		if fence.open() {
+			continue
+		}
+
+		if match := entryHeadingRe.FindStringSubmatch(line); match != nil {
+			closeCurrent(i)
+			entries = append(entries, journalEntry{
+				date:  date,
+				time:  match[1],
+				index: len(entries) + 1,
+				start: i,
+			})
+			current = len(entries) - 1
+			continue
+		}
+
+		// Any other heading at the same or a higher level ends the block
+		if level, _, ok := parseHeading(line); ok && level <= 3 {
+			closeCurrent(i)
+		}
+	}
+	closeCurrent(len(lines))
+
+	return entries
+}
+
+// getJournalEntryPath returns the file path of the journal for a date
+func getJournalEntryPath(date string) string {
+	return filepath.Join(getJournalDir(), date+".md")
+}
+
+// loadJournalEntries reads the blocks of the journal for a date
+func loadJournalEntries(date string) ([]journalEntry, error) {
+	content, err := os.ReadFile(getJournalEntryPath(date))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no journal entry for %s", date)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %v", err)
+	}
+	return parseJournalEntries(date, string(content)), nil
+}
+
+// rewriteJournalEntry replaces (or with nil removes) the lines of a single block
+func rewriteJournalEntry(date string, index int, replacement []string) error {
+	path := getJournalEntryPath(date)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading file: %v", err)
+	}
+
+	entries := parseJournalEntries(date, string(content))
+	if index < 1 || index > len(entries) {
+		return fmt.Errorf("entry %d not found in %s (%d entries)", index, date, len(entries))
+	}
+	entry := entries[index-1]
+
+	lines := strings.Split(string(content), "\n")
+	var result []string
+	result = append(result, lines[:entry.start]...)
+	result = append(result, replacement...)
+	result = append(result, lines[entry.end:]...)
+
+	updated := strings.TrimRight(strings.Join(result, "\n"), "\n") + "\n"
+	if err := os.WriteFile(path, []byte(updated), 0644); err != nil {
+		return fmt.Errorf("error writing to file: %v", err)
+	}
+	return nil
+}
+
+// getJournalEntry returns a single block of the journal for a date
+func getJournalEntry(date string, index int) (journalEntry, error) {
+	entries, err := loadJournalEntries(date)
+	if err != nil {
+		return journalEntry{}, err
+	}
+	if index < 1 || index > len(entries) {
+		return journalEntry{}, fmt.Errorf("entry %d not found in %s (%d entries)", index, date, len(entries))
+	}
+	return entries[index-1], nil
+}
+
+// updateJournalEntry replaces the body of a single block, keeping its timestamp
+func updateJournalEntry(date string, index int, body string) error {
+	entry, err := getJournalEntry(date, index)
+	if err != nil {
+		return err
+	}
+
+	block := []string{"### " + entry.time, "", strings.TrimSpace(body), ""}
+	return rewriteJournalEntry(date, index, block)
+}
+
+// deleteJournalEntry removes a single block from a day
+func deleteJournalEntry(date string, index int) error {
+	return rewriteJournalEntry(date, index, nil)
+}
+
+// entryMatches reports whether an entry passes the tag and text filters
+func entryMatches(entry journalEntry, tag, query string) bool {
+	if tag != "" {
+		found := false
+		for _, t := range entry.tags {
+			if tagMatches(t, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if query != "" && !strings.Contains(strings.ToLower(entry.body), strings.ToLower(query)) {
+		return false
+	}
+
+	return true
+}
+
+// entryPreview returns the first line of an entry's text, shortened for lists
+func entryPreview(entry journalEntry, width int) string {
+	preview := strings.SplitN(entry.body, "\n", 2)[0]
+	if len([]rune(preview)) > width {
+		preview = string([]rune(preview)[:width-1]) + "…"
+	}
+	return preview
+}
+
+// listJournalDayEntries prints the blocks of a day that match the filters
+func listJournalDayEntries(date, tag, query string) error {
+	entries, err := loadJournalEntries(date)
+	if err != nil {
+		return err
+	}
+
+	tag = canonicalTag(tag)
+	var matching []journalEntry
+	for _, entry := range entries {
+		if entryMatches(entry, tag, query) {
+			matching = append(matching, entry)
+		}
+	}
+
+	if len(matching) == 0 {
+		fmt.Printf("📝 No matching entries on %s\n", date)
+		return nil
+	}
+
+	fmt.Printf("\n📔 Entries on %s (showing %d of %d):\n\n", date, len(matching), len(entries))
+	for _, entry := range matching {
+		fmt.Printf("  %2d. 🕒 %s  %s\n", entry.index, entry.time, entryPreview(entry, 60))
+		if len(entry.tags) > 0 {
+			fmt.Printf("      🏷️  #%s\n", strings.Join(entry.tags, " #"))
+		}
+	}
+	fmt.Println()
+	fmt.Println("💡 Use 'notetype journal entries show <n>' to read a single entry")
+	return nil
+}
+
+// parseEntryArgs reads the --date flag and the entry index argument
+func parseEntryArgs(cmd *cobra.Command, args []string) (string, int, error) {
+	date, _ := cmd.Flags().GetString("date")
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return "", 0, fmt.Errorf("invalid date '%s' (expected YYYY-MM-DD)", date)
+	}
+
+	index, err := strconv.Atoi(args[0])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid entry number '%s'", args[0])
+	}
+
+	return date, index, nil
+}
+
+var journalEntriesCmd = &cobra.Command{
+	Use:   "entries",
+	Short: "List the timestamped entries of a day",
+	Long: `Every "### HH:MM" block in a daily journal is an entry of its own.
+
+Examples:
+  notetype journal entries                        # Today's entries
+  notetype journal entries --date 2025-01-15      # Entries of a given day
+  notetype journal entries --tag work             # Only entries tagged #work
+  notetype journal entries --search meeting       # Only entries mentioning "meeting"
+  notetype journal entries show 2                 # Read the second entry
+  notetype journal entries edit 2 "New text"      # Replace the second entry
+  notetype journal entries delete 2               # Remove the second entry
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		date, _ := cmd.Flags().GetString("date")
+		tag, _ := cmd.Flags().GetString("tag")
+		query, _ := cmd.Flags().GetString("search")
+
+		if err := listJournalDayEntries(date, tag, query); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var journalEntriesShowCmd = &cobra.Command{
+	Use:   "show <n>",
+	Short: "Show a single entry",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		date, index, err := parseEntryArgs(cmd, args)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		entry, err := getJournalEntry(date, index)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("\n📔 %s 🕒 %s\n", date, entry.time)
+		fmt.Println(strings.Repeat("-", 70))
+		fmt.Println(entry.body)
+		fmt.Println(strings.Repeat("-", 70))
+	},
+}
+
+var journalEntriesEditCmd = &cobra.Command{
+	Use:   "edit <n> [content]",
+	Short: "Replace the text of a single entry",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		date, index, err := parseEntryArgs(cmd, args)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		var content string
+		if len(args) > 1 {
+			content = args[1]
+		} else {
+			fmt.Println("\n✍️  Enter the new text (press Ctrl+D or type 'EOF' on a new line to finish):")
+			fmt.Println(strings.Repeat("-", 70))
+
+			content = readMultilineInput(os.Stdin)
+			fmt.Println(strings.Repeat("-", 70))
+		}
+
+		if strings.TrimSpace(content) == "" {
+			fmt.Println("❌ no content provided")
+			os.Exit(1)
+		}
+
+		if err := updateJournalEntry(date, index, content); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Updated entry %d of %s\n", index, date)
+	},
+}
+
+var journalEntriesDeleteCmd = &cobra.Command{
+	Use:   "delete <n>",
+	Short: "Delete a single entry",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		date, index, err := parseEntryArgs(cmd, args)
+		if err == nil {
+			err = deleteJournalEntry(date, index)
+		}
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Deleted entry %d of %s\n", index, date)
+	},
+}
+
+func init() {
+	journalEntriesCmd.PersistentFlags().StringP("date", "d", getTodayFilename(), "Day of the entries (YYYY-MM-DD)")
+	journalEntriesCmd.Flags().StringP("tag", "t", "", "Only show entries with this tag")
+	journalEntriesCmd.Flags().StringP("search", "s", "", "Only show entries containing this text")
+
+	journalEntriesCmd.AddCommand(journalEntriesShowCmd)
+	journalEntriesCmd.AddCommand(journalEntriesEditCmd)
+	journalEntriesCmd.AddCommand(journalEntriesDeleteCmd)
+	journalCmd.AddCommand(journalEntriesCmd)
+}
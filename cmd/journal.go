@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -11,13 +10,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// getJournalDir returns the journal directory path
+// getJournalDir returns the directory of the active journal
 func getJournalDir() string {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return "./journal"
-	}
-	return filepath.Join(home, ".notetype", "journal")
+	return getNamedJournalDir(activeJournal)
 }
 
 // ensureJournalDir creates the journal directory if it doesn't exist
@@ -31,6 +26,32 @@ func getTodayFilename() string {
 	return time.Now().Format("2006-01-02")
 }
 
+// newJournalContent returns the start of a new day's file for the active journal,
+// built from the journal's template and default tags when it has them
+func newJournalContent(now time.Time, prompt string) (string, error) {
+	cfg, _ := getJournalConfig(activeJournal)
+
+	var structure string
+	if cfg.Template != "" {
+		rendered, _, err := renderNoteTemplate(cfg.Template, templateContext{Now: now, Title: journalTitle(activeJournal)})
+		if err != nil {
+			return "", err
+		}
+		structure = strings.TrimRight(rendered, "\n") + "\n\n"
+	} else {
+		structure = fmt.Sprintf("# %s\n\n## %s\n\n", journalTitle(activeJournal), now.Format("Monday, January 2, 2006"))
+	}
+
+	if len(cfg.Tags) > 0 {
+		structure += "#" + strings.Join(cfg.Tags, " #") + "\n\n"
+	}
+	if prompt != "" {
+		structure += fmt.Sprintf("> 💭 %s\n\n", prompt)
+	}
+
+	return structure, nil
+}
+
 // createTodayEntry creates or appends to today's journal entry
 func createTodayEntry(entry string, interactive bool) error {
 	if err := ensureJournalDir(); err != nil {
@@ -47,40 +68,30 @@ func createTodayEntry(entry string, interactive bool) error {
 		fileExists = true
 	}
 
+	// New entries get the writing prompt of the day
+	var prompt string
+	if !fileExists {
+		prompt = promptForDay(loadReminderConfig(), time.Now())
+	}
+
 	var content string
 
 	if interactive || entry == "" {
 		// Interactive mode - allow multi-line input
-		fmt.Println("\n📔 Daily Journal Entry")
+		fmt.Printf("\n📔 %s Entry\n", journalTitle(activeJournal))
 		fmt.Println(strings.Repeat("=", 70))
 		if fileExists {
 			fmt.Println("📝 Adding to today's entry...")
 		} else {
 			fmt.Println("📝 Creating today's entry...")
 		}
+		if prompt != "" {
+			fmt.Printf("\n💭 %s\n", prompt)
+		}
 		fmt.Println("\nWrite your thoughts (press Ctrl+D or type 'EOF' on a new line to finish):")
 		fmt.Println(strings.Repeat("-", 70))
 
-		reader := bufio.NewReader(os.Stdin)
-		var lines []string
-
-		for {
-			line, err := reader.ReadString('\n')
-			if err != nil {
-				// EOF reached
-				break
-			}
-
-			// Check if user typed EOF
-			trimmedLine := strings.TrimSpace(line)
-			if trimmedLine == "EOF" || trimmedLine == "eof" {
-				break
-			}
-
-			lines = append(lines, line)
-		}
-
-		content = strings.Join(lines, "")
+		content = readMultilineInput(os.Stdin)
 		fmt.Println(strings.Repeat("-", 70))
 	} else {
 		content = entry
@@ -109,17 +120,19 @@ func createTodayEntry(entry string, interactive bool) error {
 		fmt.Printf("\n✅ Added entry to today's journal (%s)\n", filename)
 	} else {
 		// Create new file
+		structure, err := newJournalContent(time.Now(), prompt)
+		if err != nil {
+			return fmt.Errorf("error applying journal template: %v", err)
+		}
+
 		file, err := os.Create(filepath)
 		if err != nil {
 			return fmt.Errorf("error creating file: %v", err)
 		}
 		defer file.Close()
 
-		currentDate := time.Now().Format("Monday, January 2, 2006")
 		timestamp := time.Now().Format("15:04")
-
-		structure := fmt.Sprintf("# Daily Journal\n\n## %s\n\n### %s\n\n%s",
-			currentDate, timestamp, content)
+		structure += fmt.Sprintf("### %s\n\n%s", timestamp, content)
 
 		_, err = file.WriteString(structure)
 		if err != nil {
@@ -239,11 +252,20 @@ var journalCmd = &cobra.Command{
 All journal entries are automatically stored in ~/.notetype/journal/
 with dates as filenames (YYYY-MM-DD.md).
 
+Besides the daily journal you can keep named journals (e.g. work, dream),
+each with its own directory, template and default tags. Select one with
+--name on any journal command.
+
 Subcommands:
   (no args)  - Create or append to today's entry (interactive mode)
   add        - Add to today's entry (interactive mode)
   view       - View today's entry
   list       - List all journal entries
+  entries    - List, show, edit or delete single timestamped entries
+  rollup     - Generate a weekly or monthly review from daily entries
+  create     - Create a named journal or change its settings
+  journals   - List all named journals
+  template   - Show or set the template used for new days
 
 Examples:
   # Write today's journal (interactive)
@@ -257,7 +279,23 @@ Examples:
   
   # List all entries
   notetype journal list
+
+  # Roll this week's entries up into a weekly review
+  notetype journal rollup --week
+
+  # Keep a separate work journal
+  notetype journal create work --template meeting --tags work
+  notetype journal --name work "Sprint planning went well"
+  notetype journal list --name work
+  notetype journal stats --all
 `,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		if err := setActiveJournal(name); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		var entry string
 		if len(args) > 0 {
@@ -295,6 +333,7 @@ var journalListCmd = &cobra.Command{
 }
 
 func init() {
+	journalCmd.PersistentFlags().StringP("name", "N", defaultJournalName, "Name of the journal to use")
 	journalListCmd.Flags().IntP("limit", "l", 0, "Limit number of entries to display (0 = all)")
 
 	journalCmd.AddCommand(journalViewCmd)
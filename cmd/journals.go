@@ -0,0 +1,432 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultJournalName is the original daily journal in ~/.notetype/journal
+const defaultJournalName = "daily"
+
+// activeJournal is the journal that journal commands and the TUI work on
+var activeJournal = defaultJournalName
+
+var journalNameRe = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// journalConfig holds the settings of a named journal
+type journalConfig struct {
+	Dir      string   `json:"dir,omitempty"`
+	Template string   `json:"template,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+}
+
+// journalConfigs are the named journals by name. Code that checks many
+// files against the journals loads them once and passes them along.
+type journalConfigs map[string]journalConfig
+
+// getJournalsConfigPath returns the path to the named journals config
+func getJournalsConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".notetype-journals.json"
+	}
+	return filepath.Join(home, ".notetype", "journals.json")
+}
+
+// loadJournalConfigs loads the named journals, always including the default one
+func loadJournalConfigs() journalConfigs {
+	configs := make(journalConfigs)
+
+	if data, err := os.ReadFile(getJournalsConfigPath()); err == nil {
+		if err := json.Unmarshal(data, &configs); err != nil {
+			configs = make(journalConfigs)
+		}
+	}
+	delete(configs, "")
+
+	if _, exists := configs[defaultJournalName]; !exists {
+		configs[defaultJournalName] = journalConfig{}
+	}
+	return configs
+}
+
+// saveJournalConfigs saves the named journals config
+func saveJournalConfigs(configs map[string]journalConfig) error {
+	configPath := getJournalsConfigPath()
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(configs, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(configPath, data, 0644)
+}
+
+// getJournalConfig returns the settings of a named journal
+func getJournalConfig(name string) (journalConfig, bool) {
+	cfg, exists := loadJournalConfigs()[name]
+	return cfg, exists
+}
+
+// getNamedJournalDir returns the directory of a named journal
+func getNamedJournalDir(name string) string {
+	return loadJournalConfigs().dir(name)
+}
+
+// dir returns the directory of a named journal
+func (c journalConfigs) dir(name string) string {
+	if cfg, exists := c[name]; exists && cfg.Dir != "" {
+		return cfg.Dir
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		if name == defaultJournalName {
+			return "./journal"
+		}
+		return filepath.Join("./journals", name)
+	}
+
+	if name == defaultJournalName {
+		return filepath.Join(home, ".notetype", "journal")
+	}
+	return filepath.Join(home, ".notetype", "journals", name)
+}
+
+// journalNames returns all journals, the default one first
+func journalNames() []string {
+	return loadJournalConfigs().names()
+}
+
+// names returns all journals, the default one first
+func (c journalConfigs) names() []string {
+	var names []string
+	for name := range c {
+		if name != defaultJournalName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return append([]string{defaultJournalName}, names...)
+}
+
+// journalTitle returns the heading used for new entries of a journal
+func journalTitle(name string) string {
+	if name == defaultJournalName || name == "" {
+		return "Daily Journal"
+	}
+	return strings.ToUpper(name[:1]) + name[1:] + " Journal"
+}
+
+// setActiveJournal switches the journal used by journal commands and the TUI
+func setActiveJournal(name string) error {
+	if _, exists := getJournalConfig(name); !exists {
+		return fmt.Errorf("journal '%s' not found. Create it with 'notetype journal create %s'", name, name)
+	}
+	activeJournal = name
+	return nil
+}
+
+// journalDirsInScope returns the journal directories tags and searches should scan
+func journalDirsInScope(name string) []string {
+	configs := loadJournalConfigs()
+	if name != "" {
+		return []string{configs.dir(name)}
+	}
+
+	var dirs []string
+	for _, journal := range configs.names() {
+		dirs = append(dirs, configs.dir(journal))
+	}
+	return dirs
+}
+
+// journalFor returns the journal a file belongs to, if any
+func (c journalConfigs) journalFor(path string) (string, bool) {
+	dir := filepath.Clean(filepath.Dir(path))
+	for _, name := range c.names() {
+		if filepath.Clean(c.dir(name)) == dir {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// listJournals prints every journal with its entry count
+func listJournals() {
+	configs := loadJournalConfigs()
+
+	fmt.Print("\n📚 Journals:\n\n")
+	for _, name := range configs.names() {
+		cfg := configs[name]
+		files, _ := filepath.Glob(filepath.Join(configs.dir(name), "*.md"))
+
+		indicator := "  "
+		if name == activeJournal {
+			indicator = "✓ "
+		}
+		fmt.Printf("%s%-15s (%d entries)\n", indicator, name, len(files))
+		if cfg.Template != "" {
+			fmt.Printf("   Template: %s\n", cfg.Template)
+		}
+		if len(cfg.Tags) > 0 {
+			fmt.Printf("   Tags: #%s\n", strings.Join(cfg.Tags, " #"))
+		}
+		fmt.Printf("   📍 %s\n\n", configs.dir(name))
+	}
+
+	fmt.Println("💡 Use 'notetype journal --name <journal>' to write in another journal")
+}
+
+// journalStats summarizes the days written in a journal
+type journalStats struct {
+	days    int
+	entries int
+	words   int
+	tags    map[string]bool
+	first   string
+	last    string
+}
+
+// add counts one day of a journal
+func (s *journalStats) add(date, content string) {
+	s.days++
+	s.entries += len(parseJournalEntries(date, content))
+	s.words += len(strings.Fields(content))
+	for _, tag := range extractTags(content) {
+		s.tags[tag] = true
+	}
+	if s.first == "" || date < s.first {
+		s.first = date
+	}
+	if date > s.last {
+		s.last = date
+	}
+}
+
+// getJournalStats reads every day of a journal
+func getJournalStats(name string) journalStats {
+	stats := journalStats{tags: make(map[string]bool)}
+	files, _ := filepath.Glob(filepath.Join(getNamedJournalDir(name), "*.md"))
+	for _, file := range files {
+		date := strings.TrimSuffix(filepath.Base(file), ".md")
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			continue
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		stats.add(date, string(content))
+	}
+	return stats
+}
+
+// printJournalStats prints the stats of one journal, or of several with a total
+func printJournalStats(names []string) {
+	total := journalStats{tags: make(map[string]bool)}
+
+	fmt.Print("\n📊 Journal Stats:\n\n")
+	fmt.Printf("  %-15s %6s %8s %8s %6s  %s\n", "Journal", "Days", "Entries", "Words", "Tags", "Written")
+	fmt.Println("  " + strings.Repeat("─", 70))
+	for _, name := range names {
+		stats := getJournalStats(name)
+		span := "-"
+		if stats.days > 0 {
+			span = stats.first + " → " + stats.last
+		}
+		fmt.Printf("  %-15s %6d %8d %8d %6d  %s\n", name, stats.days, stats.entries, stats.words, len(stats.tags), span)
+
+		total.days += stats.days
+		total.entries += stats.entries
+		total.words += stats.words
+		for tag := range stats.tags {
+			total.tags[tag] = true
+		}
+		if stats.days > 0 && (total.first == "" || stats.first < total.first) {
+			total.first = stats.first
+		}
+		if stats.last > total.last {
+			total.last = stats.last
+		}
+	}
+
+	if len(names) > 1 {
+		span := "-"
+		if total.days > 0 {
+			span = total.first + " → " + total.last
+		}
+		fmt.Println("  " + strings.Repeat("─", 70))
+		fmt.Printf("  %-15s %6d %8d %8d %6d  %s\n", "all", total.days, total.entries, total.words, len(total.tags), span)
+	}
+	fmt.Println()
+}
+
+var journalCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a named journal or change its settings",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		if !journalNameRe.MatchString(name) {
+			fmt.Println("❌ Journal names may only contain letters, digits, '-' and '_'")
+			os.Exit(1)
+		}
+
+		configs := loadJournalConfigs()
+		cfg, exists := configs[name]
+
+		if cmd.Flags().Changed("template") {
+			cfg.Template, _ = cmd.Flags().GetString("template")
+			if cfg.Template != "" {
+				if _, err := getTemplateContent(cfg.Template); err != nil {
+					fmt.Printf("❌ %v\n", err)
+					os.Exit(1)
+				}
+			}
+		}
+		if cmd.Flags().Changed("tags") {
+			tags, _ := cmd.Flags().GetStringSlice("tags")
+			cfg.Tags = nil
+			for _, tag := range tags {
+				if tag = strings.TrimPrefix(strings.TrimSpace(tag), "#"); tag != "" {
+					cfg.Tags = append(cfg.Tags, strings.ToLower(tag))
+				}
+			}
+		}
+		if cmd.Flags().Changed("dir") {
+			// Kept absolute so the journal is found from any folder
+			cfg.Dir, _ = cmd.Flags().GetString("dir")
+			if cfg.Dir != "" {
+				dir, err := filepath.Abs(cfg.Dir)
+				if err != nil {
+					fmt.Printf("❌ Error resolving directory: %v\n", err)
+					os.Exit(1)
+				}
+				cfg.Dir = dir
+			}
+		}
+
+		configs[name] = cfg
+		if err := saveJournalConfigs(configs); err != nil {
+			fmt.Printf("❌ Error saving journal: %v\n", err)
+			os.Exit(1)
+		}
+		if err := os.MkdirAll(configs.dir(name), 0755); err != nil {
+			fmt.Printf("❌ Error creating journal directory: %v\n", err)
+			os.Exit(1)
+		}
+
+		if exists {
+			fmt.Printf("✅ Updated journal '%s'\n", name)
+		} else {
+			fmt.Printf("✅ Created journal '%s'\n", name)
+			fmt.Printf("💡 Write in it with: notetype journal --name %s\n", name)
+		}
+	},
+}
+
+var journalTemplateCmd = &cobra.Command{
+	Use:   "template [template-name]",
+	Short: "Show or set the template used for new days",
+	Long: `Show or set the template applied when a day's journal file is first created,
+from both the CLI and the TUI. Built-in and custom templates can be used.
+
+Examples:
+  notetype journal template                    # Show the current template
+  notetype journal template daily              # Use the built-in 'daily' template
+  notetype journal template --name work standup
+  notetype journal template --none             # Go back to the plain heading
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		none, _ := cmd.Flags().GetBool("none")
+		configs := loadJournalConfigs()
+		cfg := configs[activeJournal]
+
+		if len(args) == 0 && !none {
+			if cfg.Template == "" {
+				fmt.Printf("📔 Journal '%s' uses no template\n", activeJournal)
+			} else {
+				fmt.Printf("📔 Journal '%s' uses template '%s'\n", activeJournal, cfg.Template)
+			}
+			fmt.Println("💡 Use 'notetype template list' to see available templates")
+			return
+		}
+
+		if none {
+			cfg.Template = ""
+		} else {
+			if _, err := getTemplateContent(args[0]); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(1)
+			}
+			cfg.Template = args[0]
+		}
+
+		configs[activeJournal] = cfg
+		if err := saveJournalConfigs(configs); err != nil {
+			fmt.Printf("❌ Error saving journal: %v\n", err)
+			os.Exit(1)
+		}
+
+		if cfg.Template == "" {
+			fmt.Printf("✅ Journal '%s' no longer uses a template\n", activeJournal)
+		} else {
+			fmt.Printf("✅ New days in journal '%s' now start from template '%s'\n", activeJournal, cfg.Template)
+		}
+	},
+}
+
+var journalJournalsCmd = &cobra.Command{
+	Use:   "journals",
+	Short: "List all named journals",
+	Run: func(cmd *cobra.Command, args []string) {
+		listJournals()
+	},
+}
+
+var journalStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show days, entries, words and tags written in journals",
+	Long: `Show how much has been written in a journal: days, timestamped entries,
+words and distinct tags, with the first and last day. Use --all to list
+every journal with a combined total.
+
+Examples:
+  notetype journal stats
+  notetype journal stats --name work
+  notetype journal stats --all
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if all, _ := cmd.Flags().GetBool("all"); all {
+			printJournalStats(journalNames())
+			return
+		}
+		printJournalStats([]string{activeJournal})
+	},
+}
+
+func init() {
+	journalStatsCmd.Flags().BoolP("all", "a", false, "Show every journal and a combined total")
+	journalCreateCmd.Flags().String("template", "", "Template used for new entries")
+	journalCreateCmd.Flags().StringSlice("tags", nil, "Tags added to new entries (comma separated)")
+	journalCreateCmd.Flags().String("dir", "", "Store the journal in a custom directory")
+	journalTemplateCmd.Flags().Bool("none", false, "Stop using a template for new days")
+
+	journalCmd.AddCommand(journalCreateCmd)
+	journalCmd.AddCommand(journalJournalsCmd)
+	journalCmd.AddCommand(journalTemplateCmd)
+	journalCmd.AddCommand(journalStatsCmd)
+}
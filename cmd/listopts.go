@@ -0,0 +1,465 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sortModes are the orders the TUI note and journal lists cycle through
+var sortModes = []string{"name", "title", "created", "modified", "size"}
+
+// headerDateRe matches the date line 'notetype new' writes under a note's title
+var headerDateRe = regexp.MustCompile(`^<span style="opacity:0\.5">(\d{4}-\d{2}-\d{2})</span>$`)
+
+// listPrefs remembers how each TUI list is sorted
+type listPrefs struct {
+	Sort map[string]string `json:"sort"`
+}
+
+// getListPrefsPath returns the path of the list preferences file
+func getListPrefsPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".notetype-lists.json"
+	}
+	return filepath.Join(home, ".notetype", "lists.json")
+}
+
+// loadListPrefs loads the list preferences
+func loadListPrefs() listPrefs {
+	prefs := listPrefs{Sort: make(map[string]string)}
+	if data, err := os.ReadFile(getListPrefsPath()); err == nil {
+		if err := json.Unmarshal(data, &prefs); err != nil || prefs.Sort == nil {
+			prefs.Sort = make(map[string]string)
+		}
+	}
+	return prefs
+}
+
+// saveListPrefs saves the list preferences
+func saveListPrefs(prefs listPrefs) error {
+	prefsPath := getListPrefsPath()
+	if err := os.MkdirAll(filepath.Dir(prefsPath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(prefs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(prefsPath, data, 0644)
+}
+
+// listSortMode returns how a list is sorted. Notes start sorted by name and
+// journal days newest first.
+func listSortMode(list string) string {
+	if mode := loadListPrefs().Sort[list]; slices.Contains(sortModes, mode) {
+		return mode
+	}
+	if list == "journals" {
+		return "created"
+	}
+	return "name"
+}
+
+// setListSortMode remembers how a list is sorted
+func setListSortMode(list, mode string) error {
+	prefs := loadListPrefs()
+	prefs.Sort[list] = mode
+	return saveListPrefs(prefs)
+}
+
+// nextSortMode returns the sort mode after mode
+func nextSortMode(mode string) string {
+	return sortModes[(slices.Index(sortModes, mode)+1)%len(sortModes)]
+}
+
+// describeSortMode explains a sort mode, including its direction
+func describeSortMode(mode string) string {
+	switch mode {
+	case "created", "modified":
+		return mode + ", newest first"
+	case "size":
+		return "size, largest first"
+	default:
+		return mode
+	}
+}
+
+// noteHeading returns a note's title from its front matter or first H1
+func noteHeading(content string) string {
+	lines := strings.Split(content, "\n")
+
+	start := 0
+	if _, bodyStart := frontMatterTagSpans(content); bodyStart > 0 {
+		start = strings.Count(content[:bodyStart], "\n")
+		for _, line := range lines[1:start] {
+			if key, value, found := strings.Cut(line, ":"); found && strings.EqualFold(strings.TrimSpace(key), "title") {
+				return strings.Trim(strings.TrimSpace(value), `"'`)
+			}
+		}
+	}
+
+	var fence codeFence
+	for _, line := range lines[start:] {
+		if !fence.step(line) && !fence.open() && strings.HasPrefix(line, "# ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "# "))
+		}
+	}
+	return ""
+}
+
+// noteCreated works out when a note was created: the day of a journal
+// file, a "created:" or "date:" in front matter, or the date 'notetype new'
+// puts under the title. Other notes use the file's creation time where the
+// system records it, and otherwise when they were modified.
+func noteCreated(journals journalConfigs, path, content string, info os.FileInfo) time.Time {
+	if _, ok := journals.journalFor(path); ok {
+		if day, err := time.ParseInLocation("2006-01-02", strings.TrimSuffix(filepath.Base(path), ".md"), time.Local); err == nil {
+			return day
+		}
+	}
+
+	lines := strings.Split(content, "\n")
+	if _, bodyStart := frontMatterTagSpans(content); bodyStart > 0 {
+		for _, line := range lines[1:strings.Count(content[:bodyStart], "\n")] {
+			key, value, found := strings.Cut(line, ":")
+			key = strings.ToLower(strings.TrimSpace(key))
+			if !found || (key != "created" && key != "date") {
+				continue
+			}
+			value = strings.Trim(strings.TrimSpace(value), `"'`)
+			for _, layout := range []string{time.RFC3339, "2006-01-02 15:04", "2006-01-02"} {
+				if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+					return t
+				}
+			}
+		}
+	}
+
+	for _, line := range lines[:min(len(lines), 5)] {
+		if match := headerDateRe.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			if day, err := time.ParseInLocation("2006-01-02", match[1], time.Local); err == nil {
+				return day
+			}
+		}
+	}
+
+	if born, ok := fileBirthTime(path, info); ok {
+		return born
+	}
+	return info.ModTime()
+}
+
+// noteDetails is what the TUI lists read from inside a note
+type noteDetails struct {
+	modified time.Time
+	size     int64
+	heading  string
+	created  time.Time
+	tags     []string
+}
+
+// noteDetailsCache keeps the details of every note read, so reloading a
+// list only reads the notes that changed since. Only the TUI's Update uses
+// it, so it needs no lock.
+var noteDetailsCache = make(map[string]noteDetails)
+
+// newNoteItem reads a note or journal day for the TUI lists
+func newNoteItem(journals journalConfigs, path, filename, title string) (noteItem, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return noteItem{}, err
+	}
+
+	details, ok := noteDetailsCache[path]
+	if !ok || !details.modified.Equal(info.ModTime()) || details.size != info.Size() {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return noteItem{}, err
+		}
+		details = noteDetails{
+			modified: info.ModTime(),
+			size:     info.Size(),
+			heading:  noteHeading(string(content)),
+			created:  noteCreated(journals, path, string(content), info),
+			tags:     extractTags(string(content)),
+		}
+		noteDetailsCache[path] = details
+	}
+
+	return noteItem{
+		filename: filename,
+		path:     path,
+		title:    title,
+		heading:  details.heading,
+		created:  details.created,
+		modified: info.ModTime(),
+		bytes:    info.Size(),
+		size:     formatSizeInTUI(info.Size()),
+		tags:     details.tags,
+	}, nil
+}
+
+// sortNoteItems orders notes by a sort mode, falling back to their names
+func sortNoteItems(items []noteItem, mode string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		switch mode {
+		case "title":
+			at, bt := strings.ToLower(a.heading), strings.ToLower(b.heading)
+			if at == "" {
+				at = strings.ToLower(a.title)
+			}
+			if bt == "" {
+				bt = strings.ToLower(b.title)
+			}
+			if at != bt {
+				return at < bt
+			}
+		case "created":
+			if !a.created.Equal(b.created) {
+				return a.created.After(b.created)
+			}
+		case "modified":
+			if !a.modified.Equal(b.modified) {
+				return a.modified.After(b.modified)
+			}
+		case "size":
+			if a.bytes != b.bytes {
+				return a.bytes > b.bytes
+			}
+		}
+		return a.filename < b.filename
+	})
+}
+
+// listFilter narrows a TUI list to a tag, a notebook and a range of
+// creation dates; each part set is shown as a chip above the list
+type listFilter struct {
+	tag      string
+	notebook string
+	from     time.Time
+	to       time.Time
+}
+
+// parseListFilter reads filters such as "#work notebook:projects
+// from:2024-01-01 to:2024-01-31"
+func parseListFilter(text string) (listFilter, error) {
+	var f listFilter
+	for _, field := range strings.Fields(text) {
+		key, value, found := strings.Cut(field, ":")
+		switch {
+		case strings.HasPrefix(field, "#"):
+			f.tag = normalizeTag(field)
+		case found && key == "tag":
+			f.tag = normalizeTag(value)
+		case found && key == "notebook":
+			f.notebook = strings.Trim(value, "/")
+		case found && (key == "from" || key == "to"):
+			day, err := time.ParseInLocation("2006-01-02", value, time.Local)
+			if err != nil {
+				return f, fmt.Errorf("dates are written YYYY-MM-DD, not '%s'", value)
+			}
+			if key == "from" {
+				f.from = day
+			} else {
+				f.to = day
+			}
+		default:
+			return f, fmt.Errorf("unknown filter '%s'; use #tag, notebook:<name>, from:<date> or to:<date>", field)
+		}
+	}
+
+	if f.tag != "" && !tagNameRe.MatchString(f.tag) {
+		return f, fmt.Errorf("tags may only contain letters, digits, '-', '_' and '/'")
+	}
+	if !f.from.IsZero() && !f.to.IsZero() && f.to.Before(f.from) {
+		return f, fmt.Errorf("the 'to' date is before the 'from' date")
+	}
+	return f, nil
+}
+
+func (f listFilter) isEmpty() bool {
+	return f.tag == "" && f.notebook == "" && f.from.IsZero() && f.to.IsZero()
+}
+
+// String writes the filter back in the form parseListFilter reads
+func (f listFilter) String() string {
+	var parts []string
+	if f.tag != "" {
+		parts = append(parts, "#"+f.tag)
+	}
+	if f.notebook != "" {
+		parts = append(parts, "notebook:"+f.notebook)
+	}
+	if !f.from.IsZero() {
+		parts = append(parts, "from:"+f.from.Format("2006-01-02"))
+	}
+	if !f.to.IsZero() {
+		parts = append(parts, "to:"+f.to.Format("2006-01-02"))
+	}
+	return strings.Join(parts, " ")
+}
+
+// chips returns a label for each part of the filter
+func (f listFilter) chips() []string {
+	var chips []string
+	if f.tag != "" {
+		chips = append(chips, "#"+f.tag)
+	}
+	if f.notebook != "" {
+		chips = append(chips, "📁 "+f.notebook)
+	}
+	switch {
+	case !f.from.IsZero() && !f.to.IsZero():
+		chips = append(chips, "📅 "+f.from.Format("Jan 2, 2006")+" – "+f.to.Format("Jan 2, 2006"))
+	case !f.from.IsZero():
+		chips = append(chips, "📅 since "+f.from.Format("Jan 2, 2006"))
+	case !f.to.IsZero():
+		chips = append(chips, "📅 until "+f.to.Format("Jan 2, 2006"))
+	}
+	return chips
+}
+
+// matches reports whether a note passes the filter. Dates are compared with
+// when the note was created, and the 'to' day is included.
+func (f listFilter) matches(item noteItem) bool {
+	if f.tag != "" {
+		tag := canonicalTag(f.tag)
+		if !slices.ContainsFunc(item.tags, func(t string) bool { return tagMatches(t, tag) }) {
+			return false
+		}
+	}
+	if f.notebook != "" {
+		notebook := noteNotebook(item.path)
+		if notebook != f.notebook && !strings.HasPrefix(notebook, f.notebook+"/") {
+			return false
+		}
+	}
+	if !f.from.IsZero() && item.created.Before(f.from) {
+		return false
+	}
+	if !f.to.IsZero() && !item.created.Before(f.to.AddDate(0, 0, 1)) {
+		return false
+	}
+	return true
+}
+
+// currentFilter returns the filter of the notes or journals list shown
+func (m *model) currentFilter() *listFilter {
+	if m.isJournal {
+		return &m.dayFilter
+	}
+	return &m.noteFilter
+}
+
+// listHeight is the height of a list, leaving room for its filter chips
+func (m model) listHeight(f listFilter) int {
+	if f.isEmpty() {
+		return m.height - 8
+	}
+	return m.height - 9
+}
+
+// Switch the list shown to its next sort mode and remember it
+func (m model) cycleSort() (tea.Model, tea.Cmd) {
+	name := "notes"
+	if m.isJournal {
+		name = "journals"
+	}
+	mode := nextSortMode(listSortMode(name))
+	err := setListSortMode(name, mode)
+
+	next, cmd := m.reloadList()
+	nm := next.(model)
+	if err != nil {
+		nm.statusMsg = "Error saving sort order: " + err.Error()
+	} else {
+		nm.statusMsg = "Sorted by " + describeSortMode(mode)
+	}
+	return nm, cmd
+}
+
+// Reload the notes or journals list shown
+func (m model) reloadList() (tea.Model, tea.Cmd) {
+	if m.isJournal {
+		return m.loadJournals()
+	}
+	return m.loadNotes()
+}
+
+// Ask for the filters of the list shown
+func (m model) startFilter() (tea.Model, tea.Cmd) {
+	m.filterInput = textinput.New()
+	m.filterInput.Prompt = "🔎 "
+	m.filterInput.Placeholder = "#tag notebook:<name> from:YYYY-MM-DD to:YYYY-MM-DD"
+	if m.isJournal {
+		m.filterInput.Placeholder = "#tag from:YYYY-MM-DD to:YYYY-MM-DD"
+	}
+	m.filterInput.SetValue(m.currentFilter().String())
+	m.filterInput.Width = m.width - 10
+	m.styles.styleInput(&m.filterInput)
+	m.filterInput.Focus()
+	m.mode = filterView
+	m.statusMsg = "Enter to apply, empty to clear, Esc to cancel"
+	return m, textinput.Blink
+}
+
+// Apply the entered filters and reload the list
+func (m model) applyFilter(text string) (tea.Model, tea.Cmd) {
+	f, err := parseListFilter(text)
+	if err == nil && m.isJournal && f.notebook != "" {
+		err = fmt.Errorf("journal days aren't in notebooks")
+	}
+	if err == nil {
+		err = checkNotebook(f.notebook)
+	}
+	if err != nil {
+		m.statusMsg = "Error: " + err.Error()
+		return m, nil
+	}
+
+	*m.currentFilter() = f
+	return m.reloadList()
+}
+
+// Clear the filters of the list shown
+func (m model) clearFilter() (tea.Model, tea.Cmd) {
+	*m.currentFilter() = listFilter{}
+	next, cmd := m.reloadList()
+	nm := next.(model)
+	nm.statusMsg = "Filters cleared"
+	return nm, cmd
+}
+
+// renderFilterChips shows the active filters above a list
+func (m model) renderFilterChips(f listFilter) string {
+	var chips []string
+	for _, chip := range f.chips() {
+		chips = append(chips, m.styles.Chip.Render(chip))
+	}
+	chips = append(chips, m.styles.MutedText.Render("f edit • x clear"))
+	return lipgloss.JoinHorizontal(lipgloss.Top, chips...)
+}
+
+func (m model) renderFilter() string {
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.styles.Header.Render("🔎 Filter the list"),
+		m.styles.Dialog.Width(m.width-4).Render(m.filterInput.View()),
+		m.styles.MutedText.Render("Dates are when a note was created; leave empty to show everything"),
+		m.styles.ActiveButton.Render("↵ Apply (Enter)"),
+	)
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+var (
+	frontMatterTagRe = regexp.MustCompile(`#?(\w[\w-]*(?:/[\w-]+)*)`)
+	numericTagRe     = regexp.MustCompile(`^\d+$`)
+)
+
+// markdownParser reads note bodies: CommonMark with the GitHub extensions,
+// so bare URLs are links rather than text
+var markdownParser = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+// tagSpan is the position of a tag name, without its #, in a document
+type tagSpan struct {
+	start int
+	end   int
+}
+
+// findTagSpans returns every tag in a Markdown document. Tags inside code
+// blocks, inline code, link URLs, HTML and comments don't count, and
+// neither do bare numbers like #123. Tags listed under "tags:" in front
+// matter are included.
+func findTagSpans(content string) []tagSpan {
+	spans, bodyStart := frontMatterTagSpans(content)
+
+	for _, span := range bodyTagSpans(content, bodyStart) {
+		if numericTagRe.MatchString(content[span.start:span.end]) {
+			continue
+		}
+		spans = append(spans, span)
+	}
+
+	return spans
+}
+
+// frontMatterTagSpans finds the tags in a document's front matter and
+// returns them along with the offset where the body starts
+func frontMatterTagSpans(content string) ([]tagSpan, int) {
+	if !strings.HasPrefix(content, "---\n") && !strings.HasPrefix(content, "---\r\n") {
+		return nil, 0
+	}
+
+	var spans []tagSpan
+	inTags := false
+	offset := strings.Index(content, "\n") + 1
+
+	for offset < len(content) {
+		lineEnd := strings.Index(content[offset:], "\n")
+		if lineEnd == -1 {
+			lineEnd = len(content) - offset
+		}
+		line := content[offset : offset+lineEnd]
+		next := offset + lineEnd + 1
+
+		if strings.TrimSpace(line) == "---" {
+			return spans, min(next, len(content))
+		}
+
+		// Either "tags: [a, b]" / "tags: a, b" or a "tags:" block of "- a" items
+		value, valueStart := "", offset
+		if key, rest, found := strings.Cut(line, ":"); found && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "-") {
+			inTags = strings.EqualFold(strings.TrimSpace(key), "tags")
+			value, valueStart = rest, offset+len(key)+1
+		} else if inTags && strings.HasPrefix(strings.TrimSpace(line), "-") {
+			value = line
+		}
+
+		if inTags && value != "" {
+			for _, match := range frontMatterTagRe.FindAllStringSubmatchIndex(value, -1) {
+				spans = append(spans, tagSpan{valueStart + match[2], valueStart + match[3]})
+			}
+		}
+
+		offset = next
+	}
+
+	// Without a closing '---' this was never front matter
+	return nil, 0
+}
+
+// bodyTagSpans parses a document body as CommonMark, with the GitHub
+// extensions, and finds the tags in its text. Code, HTML, autolinks and link
+// destinations aren't text, so tags in them are never found.
+func bodyTagSpans(content string, bodyStart int) []tagSpan {
+	source := []byte(content[bodyStart:])
+	doc := markdownParser.Parser().Parse(text.NewReader(source))
+
+	var spans []tagSpan
+	find := func(start, end int) {
+		for _, match := range tagRe.FindAllSubmatchIndex(source[start:end], -1) {
+			spans = append(spans, tagSpan{bodyStart + start + match[2], bodyStart + start + match[3]})
+		}
+	}
+
+	ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node.Kind() {
+		case ast.KindCodeSpan, ast.KindAutoLink, ast.KindRawHTML:
+			return ast.WalkSkipChildren, nil
+		case ast.KindText:
+			// The inline parser splits text at characters such as '_', so
+			// runs of text that touch in the source are searched together
+			first := node.(*ast.Text)
+			if prev, ok := first.PreviousSibling().(*ast.Text); ok && adjacentText(prev, first) {
+				return ast.WalkContinue, nil
+			}
+			last := first
+			for next, ok := last.NextSibling().(*ast.Text); ok && adjacentText(last, next); next, ok = last.NextSibling().(*ast.Text) {
+				last = next
+			}
+			find(first.Segment.Start, last.Segment.Stop)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	return spans
+}
+
+// adjacentText reports whether one text node ends where the next one starts
+func adjacentText(prev, next *ast.Text) bool {
+	return prev.Segment.Stop == next.Segment.Start
+}
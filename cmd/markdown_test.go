@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the .golden files in testdata")
+
+// TestFindTagSpans checks the tags found in each testdata/markdown/*.md
+// document against the list in its .golden file, one tag per line
+func TestFindTagSpans(t *testing.T) {
+	docs, err := filepath.Glob(filepath.Join("testdata", "markdown", "*.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) == 0 {
+		t.Fatal("no documents in testdata/markdown")
+	}
+
+	for _, doc := range docs {
+		name := strings.TrimSuffix(filepath.Base(doc), ".md")
+		t.Run(name, func(t *testing.T) {
+			content, err := os.ReadFile(doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got strings.Builder
+			for _, span := range findTagSpans(string(content)) {
+				got.WriteString(string(content[span.start:span.end]) + "\n")
+			}
+
+			golden := strings.TrimSuffix(doc, ".md") + ".golden"
+			if *updateGolden {
+				if err := os.WriteFile(golden, []byte(got.String()), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%v (run 'go test ./cmd -run TestFindTagSpans -update' to create it)", err)
+			}
+			if got.String() != string(want) {
+				t.Errorf("tags in %s:\ngot:\n%s\nwant:\n%s", doc, got.String(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// moveOptions controls what 'notetype mv' changes besides the file name
+type moveOptions struct {
+	title   string // new title for the front matter and H1
+	retitle bool   // derive the title from the new name
+	links   bool   // rewrite links to the note in other notes and journals, and its own relative links
+}
+
+var (
+	markdownLinkRe = regexp.MustCompile(`\]\(([^)\s#<>]+)([#)\s])`)
+	urlSchemeRe    = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+)
+
+// moveResult reports what a move changed
+type moveResult struct {
+	title      string
+	links      int
+	linkedFrom int
+}
+
+// resolveNotePath turns a note name such as "ideas" or "work/ideas", or a
+// journal day such as "journal:2024-01-15" or "journal:work/2024-01-15",
+// into the path of its file
+func resolveNotePath(spec string) (string, error) {
+	if rest, ok := strings.CutPrefix(spec, "journal:"); ok {
+		name, date := activeJournal, strings.TrimSuffix(rest, ".md")
+		if before, after, found := strings.Cut(date, "/"); found {
+			name, date = before, after
+		}
+		journals := loadJournalConfigs()
+		if _, exists := journals[name]; !exists {
+			return "", fmt.Errorf("journal '%s' not found", name)
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return "", fmt.Errorf("journal days are named by date (YYYY-MM-DD), not '%s'", date)
+		}
+		return filepath.Join(journals.dir(name), date+".md"), nil
+	}
+
+	name, err := cleanNotePath(strings.TrimSuffix(spec, ".md"))
+	if err != nil {
+		return "", err
+	}
+	return name + ".md", nil
+}
+
+// noteLinkName returns the name used in [[links]] to a note, or "" for a
+// journal day
+func noteLinkName(journals journalConfigs, path string) string {
+	if _, ok := journals.journalFor(path); ok {
+		return ""
+	}
+	return filepath.ToSlash(strings.TrimSuffix(path, ".md"))
+}
+
+// retitleNote sets the "title:" in front matter and the first H1 heading,
+// returning whether anything changed
+func retitleNote(content, title string) (string, bool) {
+	lines := strings.Split(content, "\n")
+	changed := false
+
+	start := 0
+	if _, bodyStart := frontMatterTagSpans(content); bodyStart > 0 {
+		start = strings.Count(content[:bodyStart], "\n")
+		for i := 1; i < start; i++ {
+			key, _, found := strings.Cut(lines[i], ":")
+			if found && !strings.HasPrefix(key, " ") && strings.EqualFold(strings.TrimSpace(key), "title") {
+				lines[i] = key + ": " + title
+				changed = true
+			}
+		}
+	}
+
+	var fence codeFence
+	for i := start; i < len(lines); i++ {
+		if fence.step(lines[i]) {
+			continue
+		}
+		if !fence.open() && strings.HasPrefix(lines[i], "# ") {
+			if lines[i] != "# "+title {
+				lines[i] = "# " + title
+				changed = true
+			}
+			break
+		}
+	}
+
+	return strings.Join(lines, "\n"), changed
+}
+
+// renameLinks points [[wiki links]] and Markdown links at a moved note.
+// Markdown links are matched by their path relative to the linking file,
+// or by absolute path.
+func renameLinks(journals journalConfigs, content, file, from, to string) (string, int) {
+	count := 0
+
+	if oldName, newName := noteLinkName(journals, from), noteLinkName(journals, to); oldName != "" && newName != "" {
+		wikiRe := regexp.MustCompile(`\[\[` + regexp.QuoteMeta(oldName) + `((?:[|#][^\[\]]*)?)\]\]`)
+		content = wikiRe.ReplaceAllStringFunc(content, func(link string) string {
+			count++
+			return wikiRe.ReplaceAllString(link, "[["+newName+"${1}]]")
+		})
+	}
+
+	dir := filepath.Dir(file)
+	destinations := map[string]string{}
+	if absFrom, err := filepath.Abs(from); err == nil {
+		absTo, _ := filepath.Abs(to)
+		destinations[absFrom] = absTo
+	}
+	if relFrom, err := filepath.Rel(dir, from); err == nil {
+		relTo, _ := filepath.Rel(dir, to)
+		destinations[filepath.ToSlash(relFrom)] = filepath.ToSlash(relTo)
+	}
+	for oldDest, newDest := range destinations {
+		linkRe := regexp.MustCompile(`\]\(` + regexp.QuoteMeta(oldDest) + `([#)\s])`)
+		content = linkRe.ReplaceAllStringFunc(content, func(link string) string {
+			count++
+			return linkRe.ReplaceAllString(link, "]("+newDest+"${1}")
+		})
+	}
+
+	return content, count
+}
+
+// rebaseLinks keeps the relative Markdown links of a note pointing at the
+// same files when the note moves from one folder to another
+func rebaseLinks(content, fromDir, toDir string) (string, int) {
+	count := 0
+	content = markdownLinkRe.ReplaceAllStringFunc(content, func(link string) string {
+		match := markdownLinkRe.FindStringSubmatch(link)
+		dest := match[1]
+		if urlSchemeRe.MatchString(dest) || strings.HasPrefix(dest, "/") {
+			return link
+		}
+		rel, err := filepath.Rel(toDir, filepath.Join(fromDir, filepath.FromSlash(dest)))
+		if err != nil || filepath.ToSlash(rel) == dest {
+			return link
+		}
+		count++
+		return "](" + filepath.ToSlash(rel) + match[2]
+	})
+	return content, count
+}
+
+// linkFile places content at path without ever replacing an existing file.
+// A hard link is created from a temporary file in the same folder, so the
+// note appears all at once; filesystems without hard links fall back to an
+// exclusive create.
+func linkFile(path string, content []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".notetype-mv-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(content)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), perm)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.Link(tmp.Name(), path)
+	if err == nil || os.IsExist(err) {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+	return file.Close()
+}
+
+// moveNote renames a note or journal day, creating folders as needed. It
+// refuses to overwrite an existing file, and the old file is only removed
+// once the new one is complete.
+func moveNote(from, to string, opts moveOptions) (moveResult, error) {
+	var result moveResult
+	journals := loadJournalConfigs()
+
+	info, err := os.Stat(from)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, fmt.Errorf("'%s' not found", from)
+		}
+		return result, err
+	}
+	if filepath.Clean(from) == filepath.Clean(to) {
+		return result, fmt.Errorf("'%s' is already called that", from)
+	}
+	if _, err := os.Stat(to); err == nil {
+		return result, fmt.Errorf("'%s' already exists; pick another name or remove it first", to)
+	}
+
+	result.title = opts.title
+	if opts.retitle && result.title == "" {
+		if name, ok := journals.journalFor(to); ok {
+			result.title = journalTitle(name)
+		} else {
+			result.title = titleFromFilename(to)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return result, fmt.Errorf("error creating folder: %v", err)
+	}
+
+	// Without a new title or folder the file can simply be hard linked to
+	// its new name
+	newFolder := opts.links && filepath.Clean(filepath.Dir(from)) != filepath.Clean(filepath.Dir(to))
+	ownLinks := 0
+	err = os.ErrInvalid
+	if result.title == "" && !newFolder {
+		err = os.Link(from, to)
+	}
+	if err != nil && !os.IsExist(err) {
+		var content []byte
+		if content, err = os.ReadFile(from); err != nil {
+			return result, err
+		}
+		if result.title != "" {
+			updated, _ := retitleNote(string(content), result.title)
+			content = []byte(updated)
+		}
+		if newFolder {
+			var updated string
+			updated, ownLinks = rebaseLinks(string(content), filepath.Dir(from), filepath.Dir(to))
+			content = []byte(updated)
+		}
+		err = linkFile(to, content, info.Mode().Perm())
+	}
+	if os.IsExist(err) {
+		return result, fmt.Errorf("'%s' already exists; pick another name or remove it first", to)
+	}
+	if err != nil {
+		return result, fmt.Errorf("error moving note: %v", err)
+	}
+
+	if err := os.Remove(from); err != nil {
+		return result, fmt.Errorf("copied to '%s' but could not remove '%s': %v", to, from, err)
+	}
+	if err := movePinned(from, to); err != nil {
+		return result, fmt.Errorf("moved, but could not update pinned notes: %v", err)
+	}
+
+	if ownLinks > 0 {
+		result.links += ownLinks
+		result.linkedFrom++
+	}
+	if opts.links {
+		for _, file := range taggedFiles() {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				continue
+			}
+			updated, count := renameLinks(journals, string(content), file, from, to)
+			if count == 0 {
+				continue
+			}
+			fileInfo, err := os.Stat(file)
+			if err != nil {
+				continue
+			}
+			if err := os.WriteFile(file, []byte(updated), fileInfo.Mode().Perm()); err != nil {
+				return result, fmt.Errorf("moved, but could not update links in %s: %v", file, err)
+			}
+			result.links += count
+			if ownLinks == 0 || filepath.Clean(file) != filepath.Clean(to) {
+				result.linkedFrom++
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// mvCmd renames and moves notes
+var mvCmd = &cobra.Command{
+	Use:   "mv <old> <new>",
+	Short: "Rename or move a note",
+	Args:  cobra.ExactArgs(2),
+	Long: `Rename a note, move it into a folder, or move a journal day to another
+journal. Folders are created as needed and an existing note is never
+overwritten. The new file is complete before the old one is removed.
+
+Links to the note in other notes and journals are updated: [[wiki links]]
+and Markdown links by relative or absolute path. When the note changes
+folder, its own relative Markdown links are rewritten to point at the same
+files. Tags live inside the note, so they move with it.
+
+Journal days are written as journal:<date> for the active journal, or
+journal:<journal>/<date> for another one.
+
+Examples:
+  notetype mv ideas project-ideas
+  notetype mv ideas work/ideas --retitle
+  notetype mv draft essay --title "Why Plain Text Wins"
+  notetype mv journal:2024-01-15 journal:work/2024-01-15
+  notetype mv journal:2024-01-15 standup-2024-01-15
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		from, err := resolveNotePath(args[0])
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		to, err := resolveNotePath(args[1])
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		var opts moveOptions
+		opts.title, _ = cmd.Flags().GetString("title")
+		opts.retitle, _ = cmd.Flags().GetBool("retitle")
+		noLinks, _ := cmd.Flags().GetBool("no-links")
+		opts.links = !noLinks
+
+		result, err := moveNote(from, to, opts)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("✅ Moved '%s' to '%s'\n", from, to)
+		if result.title != "" {
+			fmt.Printf("✏️  Title set to '%s'\n", result.title)
+		}
+		if result.links > 0 {
+			fmt.Printf("🔗 Updated %d link(s) in %d file(s)\n", result.links, result.linkedFrom)
+		}
+	},
+}
+
+func init() {
+	mvCmd.Flags().StringP("title", "t", "", "Set a new title in the front matter and H1")
+	mvCmd.Flags().Bool("retitle", false, "Set the title from the new name")
+	mvCmd.Flags().Bool("no-links", false, "Leave links to the note in other files alone")
+	rootCmd.AddCommand(mvCmd)
+}
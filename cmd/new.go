@@ -2,95 +2,227 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/spf13/cobra"
 )
 
+// noteOptions describes a note created with 'notetype new'
+type noteOptions struct {
+	filename string
+	title    string
+	body     string
+	newline  string
+	bold     string
+	italic   string
+	tags     []string
+	template string
+}
 
-func createAndAddFile(filename string, title string, entry string, newLineContent string,boldContent string,italicContent string) {
+// titleFromFilename turns a filename such as "project-ideas" into "Project Ideas"
+func titleFromFilename(filename string) string {
+	name := strings.TrimSuffix(filepath.Base(filename), ".md")
+	words := strings.FieldsFunc(name, func(r rune) bool { return r == '-' || r == '_' || r == ' ' })
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
 
-	file, err := os.Create(filename + ".md")
-	if err != nil {
-		fmt.Println(err)
+// formatNoteBody combines the body text with the --newline, --bold and
+// --italic flags
+func formatNoteBody(opts noteOptions) string {
+	body := strings.TrimRight(opts.body, "\n")
+	if opts.newline != "" {
+		body = strings.TrimLeft(body+"\n"+opts.newline, "\n")
 	}
-	defer file.Close()
+	if opts.bold != "" {
+		body = strings.TrimLeft(body+" **"+opts.bold+"**", " ")
+	}
+	if opts.italic != "" {
+		body = strings.TrimLeft(body+" *"+opts.italic+"*", " ")
+	}
+	return body
+}
+
+// renderNewNote builds the content of a new note and works out where it
+// goes, without the .md extension
+func renderNewNote(opts noteOptions, now time.Time) (string, string, error) {
+	body := formatNoteBody(opts)
+	var target, content string
+
+	if opts.template != "" {
+		ctx := templateContext{Now: now, Title: opts.title}
+		rendered, meta, err := renderNoteTemplate(opts.template, ctx)
+		if err != nil {
+			return "", "", err
+		}
+		if target, err = templateTargetPath(opts.template, meta, opts.filename, ctx); err != nil {
+			return "", "", err
+		}
 
-	// writing inside the file
-	var currentDate = time.Now().String()[0:10]
-	fmt.Println()
-	var style_open = `<span style="opacity:0.5">`
-	var style_close = "</span>"
-	var structure = "# " + title + "\n" + style_open + currentDate + style_close + "\n" + "---"
+		content = strings.TrimRight(rendered, "\n") + "\n"
+		if body != "" {
+			content += "\n" + body + "\n"
+		}
+	} else {
+		var err error
+		if target, err = cleanNotePath(opts.filename); err != nil {
+			return "", "", err
+		}
 
-	var fullEntry = entry
-	if newLineContent != ""{
-		fullEntry = entry + "\n" + newLineContent
-	}
-	
-	if boldContent != ""{
-		fullEntry = fullEntry + " **" + boldContent + "**"
+		styleOpen := `<span style="opacity:0.5">`
+		styleClose := "</span>"
+		content = "# " + opts.title + "\n" + styleOpen + now.Format("2006-01-02") + styleClose + "\n---\n"
+		if body != "" {
+			content += body + "\n"
+		}
 	}
 
-	if italicContent != ""{
-		fullEntry = fullEntry + " *" + boldContent + "*"
+	for _, tag := range opts.tags {
+		tag = normalizeTag(tag)
+		if !tagNameRe.MatchString(tag) {
+			return "", "", fmt.Errorf("invalid tag '%s': tags may only contain letters, digits, '-', '_' and '/'", tag)
+		}
+		content, _ = addTagToText(content, tag)
 	}
 
-	
+	return content, target, nil
+}
 
+// createNoteFile writes a new note, refusing to replace an existing one
+func createNoteFile(path, content string) error {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("error creating folder: %v", err)
+		}
+	}
 
-	file.WriteString(structure + "\n" + fullEntry)
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if os.IsExist(err) {
+		return fmt.Errorf("'%s' already exists. Use 'notetype update' to add to it", path)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating file: %v", err)
+	}
+	defer file.Close()
 
-	fmt.Println("File has been created succesfully")
+	if _, err := file.WriteString(content); err != nil {
+		return fmt.Errorf("error writing to file: %v", err)
+	}
+	return nil
+}
 
-	// slice to store all files in the slice
+// readNoteBody reads the body of a new note from --file, or from stdin when
+// it is piped in or the text argument is "-"
+func readNoteBody(text, file string) (string, error) {
+	if file != "" {
+		if text != "" {
+			return "", fmt.Errorf("give the note text either as an argument or with --file, not both")
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("error reading %s: %v", file, err)
+		}
+		return string(data), nil
+	}
 
+	if text == "-" || (text == "" && stdinIsPiped()) {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("error reading stdin: %v", err)
+		}
+		return string(data), nil
+	}
+
+	return text, nil
 }
 
+// stdinIsPiped reports whether stdin is a pipe or file rather than a terminal
+func stdinIsPiped() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice == 0
+}
 
 // newCmd represents the new command
 var newCmd = &cobra.Command{
-	Use:   "new",
-	Short: "helps you to add new entry to your daily journal",
-	Args:  cobra.ExactArgs(3),
-	Long: `
-		This is where you start typing your thoughts and other things that you wish to type down.
-		Don't stop and let your thoughts flow. 
-	`,
+	Use:   "new <filename> [title] [text]",
+	Short: "Create a new note",
+	Args:  cobra.RangeArgs(1, 3),
+	Long: `Create a new note. This is where you start typing your thoughts and other
+things that you wish to write down. Don't stop and let your thoughts flow.
+
+The title defaults to one made from the filename. The note text can be
+given as an argument, read from a file with --file, or piped in on stdin
+(or pass "-" as the text). An existing note is never overwritten.
+
+Examples:
+  notetype new ideas                                  # Title "Ideas"
+  notetype new standup "Daily Standup" "Shipped the parser"
+  notetype new reading-list --tag books --tag later
+  notetype new meeting-notes --template meeting --open
+  pbpaste | notetype new clipping "From the clipboard"
+  notetype new draft "Draft" --file ~/draft.txt
+  notetype new todo "Todo" "Buy milk" -n "Call mom" -b "urgent"
+`,
 	Run: func(cmd *cobra.Command, args []string) {
-		var filename = args[0]
-		var title = args[1]
-		var entry = args[2]
-		
-		var newLineEntry,err = cmd.Flags().GetString("newline")
-		if err != nil{
-			fmt.Println(err)
-			return
-		}
-		var bold,bold_err = cmd.Flags().GetString("bold")
-		
-		if bold_err != nil{
-			fmt.Println(err)
-			return 
+		opts := noteOptions{filename: strings.TrimSuffix(args[0], ".md")}
+		opts.title = titleFromFilename(opts.filename)
+		if len(args) > 1 && args[1] != "" {
+			opts.title = args[1]
 		}
 
-		var italic,italic_err = cmd.Flags().GetString("italic")
+		var text string
+		if len(args) > 2 {
+			text = args[2]
+		}
+		file, _ := cmd.Flags().GetString("file")
+		opts.newline, _ = cmd.Flags().GetString("newline")
+		opts.bold, _ = cmd.Flags().GetString("bold")
+		opts.italic, _ = cmd.Flags().GetString("italic")
+		opts.tags, _ = cmd.Flags().GetStringSlice("tag")
+		opts.template, _ = cmd.Flags().GetString("template")
+		open, _ := cmd.Flags().GetBool("open")
+
+		body, err := readNoteBody(text, file)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		opts.body = body
 
-		if italic_err != nil{
-			fmt.Println(err)
-			return 
+		content, target, err := renderNewNote(opts, time.Now())
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
 		}
+		if err := createNoteFile(target+".md", content); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("✅ Created '%s.md'\n", target)
 
-		
-		createAndAddFile(filename, title,entry,newLineEntry,bold,italic)
+		if open {
+			launchTUIEditor(target)
+		}
 	},
 }
 
 func init() {
-	newCmd.Flags().StringP("newline","n","","helps to add content in new line")
-	newCmd.Flags().StringP("bold","b","","makes your content bold")
-	newCmd.Flags().StringP("italic","i","","makes your content italic")
+	newCmd.Flags().StringP("newline", "n", "", "helps to add content in new line")
+	newCmd.Flags().StringP("bold", "b", "", "makes your content bold")
+	newCmd.Flags().StringP("italic", "i", "", "makes your content italic")
+	newCmd.Flags().StringP("file", "f", "", "Read the note text from a file")
+	newCmd.Flags().StringSliceP("tag", "t", nil, "Tag the note (repeatable)")
+	newCmd.Flags().String("template", "", "Start the note from a template")
+	newCmd.Flags().BoolP("open", "o", false, "Open the note in the editor after creating it")
 	rootCmd.AddCommand(newCmd)
-
 }
@@ -0,0 +1,372 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+// Notebooks are plain folders of notes below the notes directory, and may
+// nest. A note's name includes its notebook, as in "work/standup".
+
+// maxNotebookDepth is how many folders deep notes are looked for
+const maxNotebookDepth = 8
+
+// skippedFolders hold code dependencies rather than notes
+var skippedFolders = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	"venv":         true,
+	"__pycache__":  true,
+}
+
+// projectMarkers are files found at the top of a code project. A folder
+// holding one is a project with its own README and docs, not a notebook.
+var projectMarkers = []string{".git", "go.mod", "package.json", "Cargo.toml", "pyproject.toml"}
+
+// isProjectFolder reports whether a folder is the root of a code project
+func isProjectFolder(path string) bool {
+	for _, marker := range projectMarkers {
+		if _, err := os.Lstat(filepath.Join(path, marker)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// noteFiles returns every note in a notebook and the notebooks below it,
+// or every note when notebook is empty
+func noteFiles(notebook string) []string {
+	files, _ := scanNotes(notebook)
+	return files
+}
+
+// scanNotes walks a notebook, or every notebook when it is empty, returning
+// its notes and the notebooks below it. Hidden folders (.git and the like),
+// journal folders, dependency folders such as node_modules and code
+// projects are skipped, and so is anything more than maxNotebookDepth
+// folders down.
+func scanNotes(notebook string) ([]string, []string) {
+	root := "."
+	if notebook != "" {
+		root = filepath.FromSlash(notebook)
+	}
+
+	journals := loadJournalConfigs()
+	journalDirs := make(map[string]bool)
+	for _, name := range journals.names() {
+		if abs, err := filepath.Abs(journals.dir(name)); err == nil {
+			journalDirs[abs] = true
+		}
+	}
+
+	var files, notebooks []string
+	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path != root && (strings.HasPrefix(d.Name(), ".") || skippedFolders[d.Name()]) {
+				return filepath.SkipDir
+			}
+			if path != "." && strings.Count(filepath.ToSlash(path), "/") >= maxNotebookDepth {
+				return filepath.SkipDir
+			}
+			if path != root && isProjectFolder(path) {
+				return filepath.SkipDir
+			}
+			if abs, err := filepath.Abs(path); err == nil && journalDirs[abs] {
+				return filepath.SkipDir
+			}
+			if path != "." {
+				notebooks = append(notebooks, filepath.ToSlash(path))
+			}
+			return nil
+		}
+		if strings.HasSuffix(path, ".md") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, notebooks
+}
+
+// noteNotebook returns the notebook a note is in, or "" at the top level
+func noteNotebook(path string) string {
+	dir := filepath.ToSlash(filepath.Dir(path))
+	if dir == "." {
+		return ""
+	}
+	return dir
+}
+
+// notebookCounts returns every notebook with the number of notes in it,
+// counting nested notebooks too
+func notebookCounts(files, notebooks []string) map[string]int {
+	counts := make(map[string]int)
+	for _, notebook := range notebooks {
+		counts[notebook] = 0
+	}
+	for _, file := range files {
+		for dir := noteNotebook(file); dir != ""; dir = noteNotebook(dir) {
+			counts[dir]++
+		}
+	}
+	return counts
+}
+
+// checkNotebook returns an error unless notebook names an existing folder
+func checkNotebook(notebook string) error {
+	if notebook == "" {
+		return nil
+	}
+	info, err := os.Stat(filepath.FromSlash(notebook))
+	if err != nil || !info.IsDir() {
+		return fmt.Errorf("notebook '%s' not found", notebook)
+	}
+	return nil
+}
+
+// Folder item in the notes tree
+type folderItem struct {
+	path     string
+	count    int
+	expanded bool
+}
+
+func (f folderItem) Title() string {
+	depth := strings.Count(f.path, "/")
+	arrow := "▸"
+	if f.expanded {
+		arrow = "▾"
+	}
+	return strings.Repeat("  ", depth) + arrow + " 📁 " + f.path[strings.LastIndex(f.path, "/")+1:]
+}
+func (f folderItem) Description() string {
+	return strings.Repeat("  ", strings.Count(f.path, "/")) + fmt.Sprintf("  %d notes", f.count)
+}
+func (f folderItem) FilterValue() string { return f.path }
+
+// noteTree lays notes out as a tree: each notebook's folders first, then its
+// notes, with the contents of collapsed folders left out
+func noteTree(notes []noteItem, notebooks []string, expanded map[string]bool) []list.Item {
+	var paths []string
+	inFolder := make(map[string][]noteItem)
+	for _, note := range notes {
+		paths = append(paths, note.path)
+		inFolder[noteNotebook(note.path)] = append(inFolder[noteNotebook(note.path)], note)
+	}
+
+	counts := notebookCounts(paths, notebooks)
+	folders := make(map[string][]string)
+	for folder := range counts {
+		folders[noteNotebook(folder)] = append(folders[noteNotebook(folder)], folder)
+	}
+
+	var items []list.Item
+	var walk func(dir string)
+	walk = func(dir string) {
+		sort.Strings(folders[dir])
+		for _, folder := range folders[dir] {
+			items = append(items, folderItem{path: folder, count: counts[folder], expanded: expanded[folder]})
+			if expanded[folder] {
+				walk(folder)
+			}
+		}
+		for _, note := range inFolder[dir] {
+			items = append(items, note)
+		}
+	}
+	walk("")
+	return items
+}
+
+// notebooksCmd lists and creates notebooks
+var notebooksCmd = &cobra.Command{
+	Use:     "notebooks",
+	Aliases: []string{"notebook"},
+	Short:   "List notebooks (folders of notes)",
+	Long: `Notebooks are folders of notes, and can be nested. Create a note in one by
+giving its path, and move notes between notebooks with 'notetype mv'.
+
+Every folder below the current directory is searched for notes, up to 8
+levels deep. Hidden folders such as .git, journal folders and dependency
+folders (node_modules, vendor, venv and __pycache__) are skipped, and so are
+code projects: folders holding a .git, go.mod, package.json, Cargo.toml or
+pyproject.toml. Their READMEs are never read or rewritten as notes.
+
+Examples:
+  notetype notebooks                  # List notebooks with note counts
+  notetype notebooks create work/meetings
+  notetype new work/standup "Standup"
+  notetype mv ideas work/ideas
+  notetype tags --notebook work       # Only count tags used in 'work'
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		counts := notebookCounts(scanNotes(""))
+		if len(counts) == 0 {
+			fmt.Println("📁 No notebooks yet. Create one with 'notetype notebooks create <name>'")
+			return
+		}
+
+		var names []string
+		for name := range counts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fmt.Println("\n📁 Notebooks:")
+		fmt.Println(strings.Repeat("─", 50))
+		for _, name := range names {
+			depth := strings.Count(name, "/")
+			fmt.Printf("  %s%s (%d)\n", strings.Repeat("  ", depth), name[strings.LastIndex(name, "/")+1:], counts[name])
+		}
+		fmt.Println()
+	},
+}
+
+var notebooksCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a notebook",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := filepath.Clean(args[0])
+		if _, err := resolveNotePath(filepath.Join(name, "x")); err != nil || strings.HasPrefix(filepath.Base(name), ".") {
+			fmt.Printf("❌ '%s' is not a notebook name; use a folder inside the notes folder\n", args[0])
+			os.Exit(1)
+		}
+		if err := checkNotebook(name); err == nil {
+			fmt.Printf("❌ Notebook '%s' already exists\n", filepath.ToSlash(name))
+			os.Exit(1)
+		}
+		if err := os.MkdirAll(name, 0755); err != nil {
+			fmt.Printf("❌ Error creating notebook: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Created notebook '%s'\n", filepath.ToSlash(name))
+	},
+}
+
+func init() {
+	notebooksCmd.AddCommand(notebooksCreateCmd)
+	rootCmd.AddCommand(notebooksCmd)
+}
+
+// Expand or collapse a notebook in the notes tree, keeping it highlighted
+func (m model) setFolderOpen(path string, open bool) (tea.Model, tea.Cmd) {
+	if m.openFolders == nil {
+		m.openFolders = make(map[string]bool)
+	}
+	m.openFolders[path] = open
+
+	next, cmd := m.loadNotes()
+	nm := next.(model)
+	for i, item := range nm.notesList.Items() {
+		if folder, ok := item.(folderItem); ok && folder.path == path {
+			nm.notesList.Select(i)
+		}
+	}
+	return nm, cmd
+}
+
+// Collapse the highlighted notebook, or the one the highlighted note is in
+func (m model) collapseFolder() (tea.Model, tea.Cmd) {
+	var path string
+	switch item := m.notesList.SelectedItem().(type) {
+	case folderItem:
+		path = item.path
+		if !item.expanded {
+			path = noteNotebook(item.path)
+		}
+	case noteItem:
+		path = noteNotebook(item.path)
+	}
+	if path == "" {
+		return m, nil
+	}
+	return m.setFolderOpen(path, false)
+}
+
+// Ask for the notebook to move the marked notes into
+func (m model) startMoveToFolder() (tea.Model, tea.Cmd) {
+	targets := targetNoteItems(m.notesList)
+	if len(targets) == 0 {
+		m.statusMsg = "Highlight or mark notes to move"
+		return m, nil
+	}
+
+	m.bulkPaths = nil
+	for _, item := range targets {
+		m.bulkPaths = append(m.bulkPaths, item.path)
+	}
+	m.folderMove = true
+	m.renameInput = textinput.New()
+	m.renameInput.Prompt = "📁 "
+	m.renameInput.Placeholder = "notebook (empty for the top level)"
+	m.renameInput.SetValue(noteNotebook(targets[0].path))
+	m.renameInput.Width = m.width - 10
+	m.styles.styleInput(&m.renameInput)
+	m.renameInput.Focus()
+	m.mode = renameView
+	m.statusMsg = "Enter to move, Esc to cancel"
+	return m, textinput.Blink
+}
+
+// Move every marked note into the entered notebook, keeping their names
+func (m model) applyMoveToFolder() (tea.Model, tea.Cmd) {
+	notebook := strings.Trim(strings.TrimSpace(m.renameInput.Value()), "/")
+
+	var plans [][2]string
+	for _, from := range m.bulkPaths {
+		to, err := resolveNotePath(filepath.Join(notebook, filepath.Base(from)))
+		if err != nil {
+			m.statusMsg = "Error: " + err.Error()
+			return m, nil
+		}
+		if filepath.Clean(from) != filepath.Clean(to) {
+			plans = append(plans, [2]string{from, to})
+		}
+	}
+
+	moved, links := 0, 0
+	var moveErr error
+	for _, plan := range plans {
+		result, err := moveNote(plan[0], plan[1], moveOptions{links: true})
+		if err != nil {
+			moveErr = err
+			break
+		}
+		moved++
+		links += result.links
+	}
+
+	if m.openFolders == nil {
+		m.openFolders = make(map[string]bool)
+	}
+	for dir := notebook; dir != "" && dir != "."; dir = noteNotebook(dir) {
+		m.openFolders[dir] = true
+	}
+
+	next, cmd := m.loadNotes()
+	nm := next.(model)
+	switch {
+	case moveErr != nil:
+		nm.statusMsg = fmt.Sprintf("Moved %d note(s), then: %v", moved, moveErr)
+	case notebook == "":
+		nm.statusMsg = fmt.Sprintf("✅ Moved %d note(s) to the top level", moved)
+	default:
+		nm.statusMsg = fmt.Sprintf("✅ Moved %d note(s) to %s", moved, notebook)
+	}
+	if links > 0 && moveErr == nil {
+		nm.statusMsg += fmt.Sprintf(" • %d link(s) updated", links)
+	}
+	return nm, cmd
+}
@@ -0,0 +1,481 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// maxRecent is how many recently opened notes are remembered
+	maxRecent = 20
+	// menuRecent is how many of them the TUI main menu shows
+	menuRecent = 5
+)
+
+// pinConfig holds pinned notes and recently opened notes and journal days,
+// by absolute path
+type pinConfig struct {
+	Pinned []string     `json:"pinned"`
+	Recent []recentNote `json:"recent"`
+}
+
+// recentNote is a note or journal day and when it was last opened or saved
+type recentNote struct {
+	Path   string    `json:"path"`
+	Opened time.Time `json:"opened"`
+}
+
+// getPinConfigPath returns the path of the pins and recent notes file
+func getPinConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".notetype-pins.json"
+	}
+	return filepath.Join(home, ".notetype", "pins.json")
+}
+
+// loadPinConfig loads pinned and recent notes
+func loadPinConfig() pinConfig {
+	var cfg pinConfig
+	if data, err := os.ReadFile(getPinConfigPath()); err == nil {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return pinConfig{}
+		}
+	}
+	return cfg
+}
+
+// savePinConfig saves pinned and recent notes
+func savePinConfig(cfg pinConfig) error {
+	configPath := getPinConfigPath()
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(configPath, data, 0644)
+}
+
+// absNotePath returns the absolute path pins and recent notes are kept by
+func absNotePath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+// pinLabel names a pinned or recent note the way 'notetype mv' and
+// 'notetype pin' accept it: "work/standup" or "journal:daily/2024-01-15"
+func pinLabel(journals journalConfigs, path string) string {
+	if journal, ok := journals.journalFor(path); ok {
+		return "journal:" + journal + "/" + strings.TrimSuffix(filepath.Base(path), ".md")
+	}
+	if cwd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(cwd, path); err == nil && !strings.HasPrefix(rel, "..") {
+			return noteLinkName(journals, rel)
+		}
+	}
+	return path
+}
+
+// isPinned reports whether a note is pinned
+func isPinned(path string) bool {
+	return slices.Contains(loadPinConfig().Pinned, absNotePath(path))
+}
+
+// setPinned pins or unpins a note, reporting whether anything changed
+func setPinned(path string, pinned bool) (bool, error) {
+	path = absNotePath(path)
+	cfg := loadPinConfig()
+
+	index := slices.Index(cfg.Pinned, path)
+	switch {
+	case pinned && index >= 0, !pinned && index < 0:
+		return false, nil
+	case pinned:
+		cfg.Pinned = append(cfg.Pinned, path)
+	default:
+		cfg.Pinned = slices.Delete(cfg.Pinned, index, index+1)
+	}
+	return true, savePinConfig(cfg)
+}
+
+// trackRecent moves a note or journal day to the top of the recent list.
+// Failing to record it never gets in the way of opening or saving.
+func trackRecent(path string) {
+	path = absNotePath(path)
+	cfg := loadPinConfig()
+
+	cfg.Recent = slices.DeleteFunc(cfg.Recent, func(r recentNote) bool { return r.Path == path })
+	cfg.Recent = append([]recentNote{{Path: path, Opened: time.Now()}}, cfg.Recent...)
+	if len(cfg.Recent) > maxRecent {
+		cfg.Recent = cfg.Recent[:maxRecent]
+	}
+	savePinConfig(cfg)
+}
+
+// movePinned keeps pins and recent notes pointing at a note after it is
+// renamed or moved
+func movePinned(from, to string) error {
+	from, to = absNotePath(from), absNotePath(to)
+	cfg := loadPinConfig()
+
+	changed := false
+	for i, path := range cfg.Pinned {
+		if path == from {
+			cfg.Pinned[i] = to
+			changed = true
+		}
+	}
+	for i, recent := range cfg.Recent {
+		if recent.Path == from {
+			cfg.Recent[i].Path = to
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return savePinConfig(cfg)
+}
+
+// recentNotes returns recently opened notes that still exist, newest
+// first, leaving out pinned ones when skipPinned is set
+func recentNotes(cfg pinConfig, skipPinned bool) []recentNote {
+	var recent []recentNote
+	for _, r := range cfg.Recent {
+		if skipPinned && slices.Contains(cfg.Pinned, r.Path) {
+			continue
+		}
+		if _, err := os.Stat(r.Path); err == nil {
+			recent = append(recent, r)
+		}
+	}
+	return recent
+}
+
+// formatAgo describes how long ago a time was, as in "5m ago"
+func formatAgo(t, now time.Time) string {
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	case d < 7*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	default:
+		return t.Format("Jan 2, 2006")
+	}
+}
+
+// Quick item is a pinned or recent note in the main menu, opened with the
+// digit shown beside it
+type quickItem struct {
+	path   string
+	label  string
+	digit  int // 0 when it has no shortcut
+	pinned bool
+	opened time.Time
+}
+
+func (q quickItem) Title() string {
+	icon := "🕒"
+	if q.pinned {
+		icon = "📌"
+	}
+	shortcut := "   "
+	if q.digit > 0 {
+		shortcut = fmt.Sprintf("[%d]", q.digit)
+	}
+	return shortcut + " " + icon + " " + q.label
+}
+func (q quickItem) Description() string {
+	if q.pinned {
+		return "    Pinned"
+	}
+	return "    Opened " + formatAgo(q.opened, time.Now())
+}
+func (q quickItem) FilterValue() string { return q.label }
+
+// Menu header titles a group of quick items; it can't be selected
+type menuHeader struct {
+	title string
+}
+
+func (h menuHeader) Title() string       { return h.title }
+func (h menuHeader) Description() string { return strings.Repeat("─", lipgloss.Width(h.title)) }
+func (h menuHeader) FilterValue() string { return "" }
+
+// quickItems returns the pinned notes, then the most recent ones, each under
+// a header, numbering the first nine for jumping to them
+func quickItems() []list.Item {
+	cfg := loadPinConfig()
+	journals := loadJournalConfigs()
+
+	var pinned, recent []quickItem
+	for _, path := range cfg.Pinned {
+		if _, err := os.Stat(path); err == nil {
+			pinned = append(pinned, quickItem{path: path, label: pinLabel(journals, path), pinned: true})
+		}
+	}
+	for i, r := range recentNotes(cfg, true) {
+		if i == menuRecent {
+			break
+		}
+		recent = append(recent, quickItem{path: r.Path, label: pinLabel(journals, r.Path), opened: r.Opened})
+	}
+
+	var items []list.Item
+	digit := 1
+	for _, group := range []struct {
+		title string
+		items []quickItem
+	}{{"📌 Pinned", pinned}, {"🕒 Recent", recent}} {
+		if len(group.items) == 0 {
+			continue
+		}
+		items = append(items, menuHeader{title: group.title})
+		for _, item := range group.items {
+			if digit <= 9 {
+				item.digit = digit
+				digit++
+			}
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Step off a menu header, carrying on in the direction the cursor moved
+// from the item at index from
+func (m model) skipMenuHeader(from int) model {
+	if _, ok := m.menuList.SelectedItem().(menuHeader); !ok {
+		return m
+	}
+	if m.menuList.Index() < from || m.menuList.Index() == len(m.menuList.Items())-1 {
+		m.menuList.CursorUp()
+	} else {
+		m.menuList.CursorDown()
+	}
+	return m
+}
+
+// Rebuild the main menu with the current pinned and recent notes
+func (m model) refreshMenu() model {
+	m.menuList.SetItems(append(mainMenuItems(), quickItems()...))
+	return m
+}
+
+// Open the pinned or recent note with the given shortcut digit
+func (m model) openQuickItem(digit int) (tea.Model, tea.Cmd) {
+	for _, item := range m.menuList.Items() {
+		if quick, ok := item.(quickItem); ok && quick.digit == digit {
+			return m.openPath(quick.path)
+		}
+	}
+	return m, nil
+}
+
+// Open a note or journal day by its path, switching journals if needed
+func (m model) openPath(path string) (tea.Model, tea.Cmd) {
+	if journal, ok := loadJournalConfigs().journalFor(path); ok {
+		if err := setActiveJournal(journal); err != nil {
+			m.statusMsg = "Error: " + err.Error()
+			return m, nil
+		}
+		return m.openJournal(strings.TrimSuffix(filepath.Base(path), ".md"))
+	}
+
+	name := path
+	if cwd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(cwd, path); err == nil {
+			name = rel
+		}
+	}
+	return m.openNote(strings.TrimSuffix(name, ".md"))
+}
+
+// Pin or unpin the highlighted note
+func (m model) togglePin() (tea.Model, tea.Cmd) {
+	item, ok := m.currentList().SelectedItem().(noteItem)
+	if !ok {
+		m.statusMsg = "Highlight a note to pin"
+		return m, nil
+	}
+
+	pinned := !isPinned(item.path)
+	if _, err := setPinned(item.path, pinned); err != nil {
+		m.statusMsg = "Error: " + err.Error()
+		return m, nil
+	}
+	if pinned {
+		m.statusMsg = "📌 Pinned " + pinLabel(loadJournalConfigs(), absNotePath(item.path))
+	} else {
+		m.statusMsg = "Unpinned " + pinLabel(loadJournalConfigs(), absNotePath(item.path))
+	}
+	return m, nil
+}
+
+// pinCmd pins notes to the top of the TUI main menu
+var pinCmd = &cobra.Command{
+	Use:   "pin [note]",
+	Short: "Pin a note, or list pinned notes",
+	Args:  cobra.MaximumNArgs(1),
+	Long: `Pin a note or journal day so it is listed in the TUI main menu, where the
+number beside it opens it straight away. Without a note, list the pins.
+
+Examples:
+  notetype pin                           # List pinned notes
+  notetype pin work/standup
+  notetype pin journal:2024-01-15        # A day in the active journal
+  notetype unpin work/standup
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			listPinned()
+			return
+		}
+
+		path, err := resolveNotePath(args[0])
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		if _, err := os.Stat(path); err != nil {
+			fmt.Printf("❌ '%s' not found\n", path)
+			os.Exit(1)
+		}
+
+		changed, err := setPinned(path, true)
+		if err != nil {
+			fmt.Printf("❌ Error saving pins: %v\n", err)
+			os.Exit(1)
+		}
+		if !changed {
+			fmt.Printf("📌 %s is already pinned\n", pinLabel(loadJournalConfigs(), absNotePath(path)))
+			return
+		}
+		fmt.Printf("📌 Pinned %s\n", pinLabel(loadJournalConfigs(), absNotePath(path)))
+	},
+}
+
+// unpinCmd removes a pin
+var unpinCmd = &cobra.Command{
+	Use:   "unpin <note>",
+	Short: "Unpin a note",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		path, err := resolveNotePath(args[0])
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		changed, err := setPinned(path, false)
+		if err != nil {
+			fmt.Printf("❌ Error saving pins: %v\n", err)
+			os.Exit(1)
+		}
+		if !changed {
+			fmt.Printf("❌ %s is not pinned\n", args[0])
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Unpinned %s\n", pinLabel(loadJournalConfigs(), absNotePath(path)))
+	},
+}
+
+// listPinned prints the pinned notes, flagging any that no longer exist
+func listPinned() {
+	cfg := loadPinConfig()
+	if len(cfg.Pinned) == 0 {
+		fmt.Println("📌 Nothing pinned yet. Pin a note with 'notetype pin <note>'")
+		return
+	}
+
+	journals := loadJournalConfigs()
+	fmt.Println("\n📌 Pinned:")
+	fmt.Println(strings.Repeat("─", 50))
+	for _, path := range cfg.Pinned {
+		if _, err := os.Stat(path); err != nil {
+			fmt.Printf("  %s (missing)\n", pinLabel(journals, path))
+			continue
+		}
+		fmt.Printf("  %s\n", pinLabel(journals, path))
+	}
+	fmt.Println()
+}
+
+// recentCmd lists recently opened notes
+var recentCmd = &cobra.Command{
+	Use:   "recent",
+	Short: "List recently opened notes and journal days",
+	Long: `List the notes and journal days most recently opened or saved in the TUI,
+newest first.
+
+Examples:
+  notetype recent
+  notetype recent -n 5
+  notetype recent --clear
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		limit, _ := cmd.Flags().GetInt("limit")
+		forget, _ := cmd.Flags().GetBool("clear")
+
+		cfg := loadPinConfig()
+		if forget {
+			cfg.Recent = nil
+			if err := savePinConfig(cfg); err != nil {
+				fmt.Printf("❌ Error saving: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Println("✅ Cleared recent notes")
+			return
+		}
+
+		recent := recentNotes(cfg, false)
+		if len(recent) == 0 {
+			fmt.Println("🕒 No recent notes yet")
+			return
+		}
+		if limit > 0 && len(recent) > limit {
+			recent = recent[:limit]
+		}
+
+		now := time.Now()
+		journals := loadJournalConfigs()
+		fmt.Println("\n🕒 Recent:")
+		fmt.Println(strings.Repeat("─", 50))
+		for _, r := range recent {
+			pin := ""
+			if slices.Contains(cfg.Pinned, r.Path) {
+				pin = " 📌"
+			}
+			fmt.Printf("  %-36s %s%s\n", pinLabel(journals, r.Path), formatAgo(r.Opened, now), pin)
+		}
+		fmt.Println()
+	},
+}
+
+func init() {
+	recentCmd.Flags().IntP("limit", "n", 10, "How many notes to show")
+	recentCmd.Flags().Bool("clear", false, "Forget the recent notes")
+	rootCmd.AddCommand(pinCmd)
+	rootCmd.AddCommand(unpinCmd)
+	rootCmd.AddCommand(recentCmd)
+}
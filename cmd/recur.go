@@ -0,0 +1,540 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// recurLookahead bounds how far 'recur list' and the schedule search look
+const recurLookahead = 366 * 24 * time.Hour
+
+// Shortcuts accepted in place of a five-field schedule
+var recurMacros = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@weekdays": "0 0 * * 1-5",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+}
+
+var recurDayNames = map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}
+
+// recurSchedule is a parsed cron-like schedule: minute hour day-of-month month day-of-week
+type recurSchedule struct {
+	spec     string
+	minutes  map[int]bool
+	hours    map[int]bool
+	days     map[int]bool
+	months   map[int]bool
+	weekdays map[int]bool
+	anyDay   bool
+	anyWeek  bool
+}
+
+// parseRecurField expands one cron field such as "*", "1-5", "*/15" or "mon,wed,fri"
+func parseRecurField(field string, min, max int, names map[string]int) (map[int]bool, error) {
+	values := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if base, stepText, found := strings.Cut(part, "/"); found {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid step in '%s'", part)
+			}
+			part, step = base, n
+		}
+
+		lo, hi := min, max
+		if part != "*" {
+			loText, hiText, isRange := strings.Cut(part, "-")
+			var err error
+			if lo, err = parseRecurValue(loText, names); err != nil {
+				return nil, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = parseRecurValue(hiText, names); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("'%s' is out of range %d-%d", part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			values[v] = true
+		}
+	}
+
+	return values, nil
+}
+
+// parseRecurValue parses a number or a day name
+func parseRecurValue(text string, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(text)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s'", text)
+	}
+	return v, nil
+}
+
+// parseRecurSchedule parses a five-field cron expression or one of the @ shortcuts
+func parseRecurSchedule(spec string) (recurSchedule, error) {
+	schedule := recurSchedule{spec: spec}
+
+	expr := strings.TrimSpace(spec)
+	if macro, ok := recurMacros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return schedule, fmt.Errorf("schedule '%s' needs 5 fields (minute hour day month weekday) or a shortcut like @weekdays", spec)
+	}
+
+	var err error
+	if schedule.minutes, err = parseRecurField(fields[0], 0, 59, nil); err != nil {
+		return schedule, fmt.Errorf("minute: %v", err)
+	}
+	if schedule.hours, err = parseRecurField(fields[1], 0, 23, nil); err != nil {
+		return schedule, fmt.Errorf("hour: %v", err)
+	}
+	if schedule.days, err = parseRecurField(fields[2], 1, 31, nil); err != nil {
+		return schedule, fmt.Errorf("day of month: %v", err)
+	}
+	if schedule.months, err = parseRecurField(fields[3], 1, 12, nil); err != nil {
+		return schedule, fmt.Errorf("month: %v", err)
+	}
+	if schedule.weekdays, err = parseRecurField(fields[4], 0, 7, recurDayNames); err != nil {
+		return schedule, fmt.Errorf("weekday: %v", err)
+	}
+	// Both 0 and 7 mean Sunday
+	if schedule.weekdays[7] {
+		schedule.weekdays[0] = true
+	}
+
+	schedule.anyDay = fields[2] == "*"
+	schedule.anyWeek = fields[4] == "*"
+	return schedule, nil
+}
+
+// matchesDay reports whether the schedule fires on a day. Like cron, a day
+// matches either field when both day-of-month and weekday are restricted.
+func (s recurSchedule) matchesDay(t time.Time) bool {
+	if !s.months[int(t.Month())] {
+		return false
+	}
+
+	dayMatch := s.days[t.Day()]
+	weekMatch := s.weekdays[int(t.Weekday())]
+	switch {
+	case s.anyDay && s.anyWeek:
+		return true
+	case s.anyDay:
+		return weekMatch
+	case s.anyWeek:
+		return dayMatch
+	default:
+		return dayMatch || weekMatch
+	}
+}
+
+// timesOn returns the times the schedule fires on a day, in order
+func (s recurSchedule) timesOn(day time.Time) []time.Time {
+	if !s.matchesDay(day) {
+		return nil
+	}
+
+	var times []time.Time
+	for hour := 0; hour < 24; hour++ {
+		if !s.hours[hour] {
+			continue
+		}
+		for minute := 0; minute < 60; minute++ {
+			if s.minutes[minute] {
+				times = append(times, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location()))
+			}
+		}
+	}
+	return times
+}
+
+// latest returns the last time the schedule fired in (after, until]
+func (s recurSchedule) latest(after, until time.Time) (time.Time, bool) {
+	if limit := until.Add(-recurLookahead); after.Before(limit) {
+		after = limit
+	}
+
+	day := time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, until.Location())
+	firstDay := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, until.Location())
+	for ; !day.Before(firstDay); day = day.AddDate(0, 0, -1) {
+		times := s.timesOn(day)
+		for i := len(times) - 1; i >= 0; i-- {
+			if times[i].After(until) {
+				continue
+			}
+			if !times[i].After(after) {
+				return time.Time{}, false
+			}
+			return times[i], true
+		}
+	}
+	return time.Time{}, false
+}
+
+// next returns the first time the schedule fires after a time
+func (s recurSchedule) next(after time.Time) (time.Time, bool) {
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, after.Location())
+	for end := after.Add(recurLookahead); day.Before(end); day = day.AddDate(0, 0, 1) {
+		for _, t := range s.timesOn(day) {
+			if t.After(after) {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// recurringTemplate is a template with a recurrence rule
+type recurringTemplate struct {
+	name     string
+	meta     templateMeta
+	schedule recurSchedule
+}
+
+// recurringTemplates returns every template that has a valid 'recur' rule,
+// printing a warning for invalid ones
+func recurringTemplates() []recurringTemplate {
+	var recurring []recurringTemplate
+	for _, name := range availableTemplates() {
+		meta, _, err := loadTemplate(name)
+		if err != nil || meta.Recur == "" {
+			continue
+		}
+
+		schedule, err := parseRecurSchedule(meta.Recur)
+		if err != nil {
+			fmt.Printf("⚠️  Template '%s' has an invalid recur rule: %v\n", name, err)
+			continue
+		}
+		recurring = append(recurring, recurringTemplate{name: name, meta: meta, schedule: schedule})
+	}
+	return recurring
+}
+
+// recurTitle is the title given to notes created on a schedule
+func recurTitle(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// getRecurStatePath returns the file that remembers which occurrences were created
+func getRecurStatePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".notetype-recur.json"
+	}
+	return filepath.Join(home, ".notetype", "recur.json")
+}
+
+// loadRecurState returns the last created occurrence of each template
+func loadRecurState() map[string]time.Time {
+	state := make(map[string]time.Time)
+	if data, err := os.ReadFile(getRecurStatePath()); err == nil {
+		if err := json.Unmarshal(data, &state); err != nil {
+			return make(map[string]time.Time)
+		}
+	}
+	return state
+}
+
+// saveRecurState saves the last created occurrence of each template
+func saveRecurState(state map[string]time.Time) error {
+	statePath := getRecurStatePath()
+	if err := os.MkdirAll(filepath.Dir(statePath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(statePath, data, 0644)
+}
+
+// runRecurring creates the notes that are due. Only the latest missed
+// occurrence of each template is created, and an occurrence is never created
+// twice: not when its note exists, nor after it was created once and removed.
+func runRecurring(now time.Time, dryRun bool) (int, error) {
+	state := loadRecurState()
+	startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	created := 0
+	for _, rt := range recurringTemplates() {
+		after, seen := state[rt.name]
+		if !seen {
+			// The first run only catches up on today
+			after = startOfToday.Add(-time.Nanosecond)
+		}
+
+		occurrence, due := rt.schedule.latest(after, now)
+		if !due {
+			continue
+		}
+
+		ctx := templateContext{Now: occurrence, Title: recurTitle(rt.name)}
+		target, err := templateTargetPath(rt.name, rt.meta, "", ctx)
+		if err != nil {
+			return created, fmt.Errorf("template '%s': %v", rt.name, err)
+		}
+
+		if _, err := os.Stat(target + ".md"); err == nil {
+			fmt.Printf("⏭️  %s.md already exists\n", target)
+		} else if dryRun {
+			fmt.Printf("📝 Would create %s.md from '%s'\n", target, rt.name)
+			continue
+		} else {
+			if _, err := writeTemplateNote(rt.name, "", ctx, false); err != nil {
+				return created, fmt.Errorf("template '%s': %v", rt.name, err)
+			}
+			fmt.Printf("✅ Created %s.md from '%s'\n", target, rt.name)
+			created++
+		}
+
+		if !dryRun {
+			state[rt.name] = occurrence
+		}
+	}
+
+	if dryRun {
+		return created, nil
+	}
+	return created, saveRecurState(state)
+}
+
+// setTemplateFrontMatterValue sets or removes one front-matter key of a template source
+func setTemplateFrontMatterValue(source, key, value string) string {
+	line := key + ": " + value
+
+	lines := strings.Split(source, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		if value == "" {
+			return source
+		}
+		return "---\n" + line + "\n---\n" + source
+	}
+
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			if value != "" {
+				lines = append(lines[:i], append([]string{line}, lines[i:]...)...)
+			}
+			break
+		}
+		if existing, _, found := strings.Cut(lines[i], ":"); found && strings.EqualFold(strings.TrimSpace(existing), key) {
+			if value == "" {
+				lines = append(lines[:i], lines[i+1:]...)
+			} else {
+				lines[i] = line
+			}
+			break
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// recurCmd represents the recur command
+var recurCmd = &cobra.Command{
+	Use:   "recur",
+	Short: "Create notes from templates on a schedule",
+	Long: `Create notes from templates on a recurring schedule.
+
+A template becomes recurring with a 'recur' key in its front matter, using a
+cron-like schedule (minute hour day-of-month month weekday) or a shortcut:
+
+  recur: 0 9 * * 1-5     # Every weekday at 09:00
+  recur: 0 16 * * fri    # Every Friday at 16:00
+  recur: @weekdays       # Every weekday at midnight
+  recur: @daily  @weekly  @monthly  @yearly
+
+'recur run' creates the notes that are due, named from the template's
+filename pattern. Running it again creates nothing new, so it can be called
+as often as you like from a local cron - no daemon needed.
+
+Examples:
+  notetype recur                          # List recurring templates and when they run next
+  notetype recur set standup "0 9 * * 1-5"
+  notetype recur set weekly "0 16 * * fri"
+  notetype recur set standup --none       # Stop recurring
+  notetype recur run                      # Create due notes in the current directory
+  notetype recur run --dry-run            # Show what would be created
+  notetype recur install                  # Print a crontab line
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		listRecurring()
+	},
+}
+
+// listRecurring prints every recurring template with its upcoming runs
+func listRecurring() {
+	recurring := recurringTemplates()
+	if len(recurring) == 0 {
+		fmt.Println("📝 No recurring templates. Add one with 'notetype recur set <template> \"0 9 * * 1-5\"'")
+		return
+	}
+
+	now := time.Now()
+	sort.Slice(recurring, func(i, j int) bool {
+		a, _ := recurring[i].schedule.next(now)
+		b, _ := recurring[j].schedule.next(now)
+		return a.Before(b)
+	})
+
+	state := loadRecurState()
+	fmt.Print("\n🔁 Recurring Templates:\n\n")
+	for _, rt := range recurring {
+		fmt.Printf("  %-15s %s\n", rt.name, rt.meta.Recur)
+
+		upcoming := now
+		var times []string
+		for i := 0; i < 3; i++ {
+			next, ok := rt.schedule.next(upcoming)
+			if !ok {
+				break
+			}
+			times = append(times, next.Format("Mon Jan 2 15:04"))
+			upcoming = next
+		}
+		if len(times) > 0 {
+			fmt.Printf("  %-15s Next: %s\n", "", strings.Join(times, ", "))
+		}
+		if last, ok := state[rt.name]; ok {
+			fmt.Printf("  %-15s Last: %s\n", "", last.Format("Mon Jan 2 15:04"))
+		}
+		fmt.Println()
+	}
+
+	fmt.Println("💡 Use 'notetype recur run' to create the notes that are due")
+}
+
+var recurListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List recurring templates and their upcoming runs",
+	Run: func(cmd *cobra.Command, args []string) {
+		listRecurring()
+	},
+}
+
+var recurRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Create the recurring notes that are due",
+	Run: func(cmd *cobra.Command, args []string) {
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		dir, _ := cmd.Flags().GetString("dir")
+
+		if dir != "" {
+			if err := os.Chdir(dir); err != nil {
+				fmt.Printf("❌ Error changing to %s: %v\n", dir, err)
+				os.Exit(1)
+			}
+		}
+
+		created, err := runRecurring(time.Now(), dryRun)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		if created == 0 && !dryRun {
+			fmt.Println("✨ Nothing due")
+		}
+	},
+}
+
+var recurSetCmd = &cobra.Command{
+	Use:   "set <template-name> [schedule]",
+	Short: "Set or clear the schedule of a template",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		none, _ := cmd.Flags().GetBool("none")
+
+		if len(args) == 1 && !none {
+			fmt.Println("❌ Give a schedule such as \"0 9 * * 1-5\", or --none to stop recurring")
+			os.Exit(1)
+		}
+
+		spec := ""
+		if !none {
+			spec = args[1]
+			if _, err := parseRecurSchedule(spec); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		source, err := getTemplateSource(name)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		if err := saveCustomTemplate(name, setTemplateFrontMatterValue(source, "recur", spec)); err != nil {
+			fmt.Printf("❌ Error saving template: %v\n", err)
+			os.Exit(1)
+		}
+
+		if spec == "" {
+			fmt.Printf("✅ Template '%s' no longer recurs\n", name)
+			return
+		}
+		fmt.Printf("✅ Template '%s' now recurs on '%s'\n", name, spec)
+		if _, builtIn := builtInTemplates[name]; builtIn {
+			fmt.Printf("💡 Saved as a custom copy that overrides the built-in '%s' template\n", name)
+		}
+	},
+}
+
+var recurInstallCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Print a crontab line that runs recurring notes",
+	Run: func(cmd *cobra.Command, args []string) {
+		executable, err := os.Executable()
+		if err != nil {
+			executable = "notetype"
+		}
+		dir, err := os.Getwd()
+		if err != nil {
+			dir = "."
+		}
+
+		fmt.Println("📋 Add this line with 'crontab -e' to check every 15 minutes:")
+		fmt.Println()
+		fmt.Printf("*/15 * * * * %s recur run --dir %q\n", executable, dir)
+		fmt.Println()
+		fmt.Println("💡 Notes are created in the directory given with --dir")
+	},
+}
+
+func init() {
+	recurRunCmd.Flags().Bool("dry-run", false, "Show what would be created without creating anything")
+	recurRunCmd.Flags().String("dir", "", "Create notes in this directory instead of the current one")
+	recurSetCmd.Flags().Bool("none", false, "Stop the template from recurring")
+
+	recurCmd.AddCommand(recurListCmd)
+	recurCmd.AddCommand(recurRunCmd)
+	recurCmd.AddCommand(recurSetCmd)
+	recurCmd.AddCommand(recurInstallCmd)
+	rootCmd.AddCommand(recurCmd)
+}
@@ -0,0 +1,364 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// reminderConfig holds the settings for journal reminders and writing prompts
+type reminderConfig struct {
+	Hour           int      `json:"hour"`
+	PromptsEnabled bool     `json:"prompts_enabled"`
+	Prompts        []string `json:"prompts"`
+}
+
+// Writing prompts used until the user configures their own
+var defaultPrompts = []string{
+	"What made you smile today?",
+	"What is one thing you learned today?",
+	"What challenged you today, and how did you respond?",
+	"Who are you grateful for right now, and why?",
+	"What would make tomorrow a great day?",
+	"What drained your energy today? What gave you energy?",
+	"Describe a moment today you want to remember.",
+	"What is something you are looking forward to?",
+	"What did you avoid today, and why?",
+	"If today had a title, what would it be?",
+}
+
+var defaultReminderConfig = reminderConfig{
+	Hour:           20,
+	PromptsEnabled: true,
+}
+
+// getReminderConfigPath returns the path to the reminder config
+func getReminderConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".notetype-remind.json"
+	}
+	return filepath.Join(home, ".notetype", "remind.json")
+}
+
+// loadReminderConfig loads the reminder config, falling back to the defaults
+func loadReminderConfig() reminderConfig {
+	cfg := defaultReminderConfig
+
+	data, err := os.ReadFile(getReminderConfigPath())
+	if err != nil {
+		return cfg
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return defaultReminderConfig
+	}
+	return cfg
+}
+
+// saveReminderConfig saves the reminder config
+func saveReminderConfig(cfg reminderConfig) error {
+	configPath := getReminderConfigPath()
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(configPath, data, 0644)
+}
+
+// reminderPrompts returns the configured prompts or the defaults
+func reminderPrompts(cfg reminderConfig) []string {
+	if len(cfg.Prompts) > 0 {
+		return cfg.Prompts
+	}
+	return defaultPrompts
+}
+
+// promptForDay picks the writing prompt for a day, rotating through the list
+func promptForDay(cfg reminderConfig, day time.Time) string {
+	if !cfg.PromptsEnabled {
+		return ""
+	}
+	prompts := reminderPrompts(cfg)
+	return prompts[day.YearDay()%len(prompts)]
+}
+
+// journalReminderDue reports whether today's journal is still missing after the configured hour
+func journalReminderDue(cfg reminderConfig, now time.Time) bool {
+	if now.Hour() < cfg.Hour {
+		return false
+	}
+
+	path := filepath.Join(getJournalDir(), getTodayFilename()+".md")
+	_, err := os.Stat(path)
+	return os.IsNotExist(err)
+}
+
+// sendDesktopNotification shows a local desktop notification when notify-send is available
+func sendDesktopNotification(title, body string) error {
+	notifier, err := exec.LookPath("notify-send")
+	if err != nil {
+		return fmt.Errorf("notify-send not found")
+	}
+	return exec.Command(notifier, title, body).Run()
+}
+
+// systemdUnits returns the service and timer units that run 'remind check' hourly
+func systemdUnits(executable string) (string, string) {
+	service := fmt.Sprintf(`[Unit]
+Description=NoteType journal reminder
+
+[Service]
+Type=oneshot
+ExecStart=%s remind check --notify
+SuccessExitStatus=1
+`, executable)
+
+	timer := `[Unit]
+Description=Hourly NoteType journal reminder
+
+[Timer]
+OnCalendar=hourly
+Persistent=true
+
+[Install]
+WantedBy=timers.target
+`
+
+	return service, timer
+}
+
+// installSystemdTimer writes the reminder units into the systemd user directory
+func installSystemdTimer(executable string) error {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return fmt.Errorf("error locating home directory: %v", err)
+	}
+
+	unitDir := filepath.Join(home, ".config", "systemd", "user")
+	if err := os.MkdirAll(unitDir, 0755); err != nil {
+		return fmt.Errorf("error creating %s: %v", unitDir, err)
+	}
+
+	service, timer := systemdUnits(executable)
+	servicePath := filepath.Join(unitDir, "notetype-remind.service")
+	timerPath := filepath.Join(unitDir, "notetype-remind.timer")
+
+	if err := os.WriteFile(servicePath, []byte(service), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %v", servicePath, err)
+	}
+	if err := os.WriteFile(timerPath, []byte(timer), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %v", timerPath, err)
+	}
+
+	fmt.Printf("✅ Wrote %s\n", servicePath)
+	fmt.Printf("✅ Wrote %s\n", timerPath)
+	fmt.Println("\n💡 Enable it with:")
+	fmt.Println("   systemctl --user daemon-reload")
+	fmt.Println("   systemctl --user enable --now notetype-remind.timer")
+	return nil
+}
+
+// remindCmd represents the remind command
+var remindCmd = &cobra.Command{
+	Use:   "remind",
+	Short: "Local journal reminders and writing prompts",
+	Long: `Get nudged when you haven't written today's journal yet.
+
+Reminders run entirely on your machine: 'remind install' sets up a systemd
+user timer or prints a crontab line that calls 'remind check' every hour.
+'remind check' exits non-zero and prints a nudge when today's journal does
+not exist after the configured hour.
+
+New journal entries also get a rotating writing prompt.
+
+Examples:
+  notetype remind                         # Show reminder settings
+  notetype remind set --hour 21           # Nudge from 21:00 on
+  notetype remind set --prompts=false     # Stop adding writing prompts
+  notetype remind prompt add "How did you move your body today?"
+  notetype remind install                 # Print a crontab line
+  notetype remind install --systemd       # Install a systemd user timer
+  notetype remind check                   # Exit 1 if today's journal is missing
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadReminderConfig()
+		fmt.Printf("⏰ Reminder hour: %02d:00\n", cfg.Hour)
+		if cfg.PromptsEnabled {
+			fmt.Printf("💭 Writing prompts: on (%d prompts)\n", len(reminderPrompts(cfg)))
+			fmt.Printf("   Today's prompt: %s\n", promptForDay(cfg, time.Now()))
+		} else {
+			fmt.Println("💭 Writing prompts: off")
+		}
+		fmt.Printf("📍 Config: %s\n", getReminderConfigPath())
+	},
+}
+
+var remindCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Exit non-zero when today's journal is missing",
+	Run: func(cmd *cobra.Command, args []string) {
+		notify, _ := cmd.Flags().GetBool("notify")
+
+		cfg := loadReminderConfig()
+		if !journalReminderDue(cfg, time.Now()) {
+			return
+		}
+
+		nudge := "You haven't written today's journal yet."
+		if prompt := promptForDay(cfg, time.Now()); prompt != "" {
+			nudge += " " + prompt
+		}
+
+		fmt.Printf("📔 %s\n", nudge)
+		fmt.Println("💡 Write it with: notetype journal")
+
+		if notify {
+			if err := sendDesktopNotification("NoteType", nudge); err != nil {
+				fmt.Printf("⚠️  Could not send notification: %v\n", err)
+			}
+		}
+
+		os.Exit(1)
+	},
+}
+
+var remindInstallCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Set up a systemd user timer or print a crontab line",
+	Run: func(cmd *cobra.Command, args []string) {
+		useSystemd, _ := cmd.Flags().GetBool("systemd")
+
+		executable, err := os.Executable()
+		if err != nil {
+			executable = "notetype"
+		}
+
+		if useSystemd {
+			if err := installSystemdTimer(executable); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+
+		fmt.Println("📋 Add this line with 'crontab -e':")
+		fmt.Println()
+		fmt.Printf("0 * * * * %s remind check --notify\n", executable)
+		fmt.Println()
+		fmt.Println("💡 Use 'notetype remind install --systemd' for a systemd user timer instead")
+	},
+}
+
+var remindSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Change reminder settings",
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadReminderConfig()
+
+		if cmd.Flags().Changed("hour") {
+			hour, _ := cmd.Flags().GetInt("hour")
+			if hour < 0 || hour > 23 {
+				fmt.Println("❌ Hour must be between 0 and 23")
+				os.Exit(1)
+			}
+			cfg.Hour = hour
+		}
+		if cmd.Flags().Changed("prompts") {
+			cfg.PromptsEnabled, _ = cmd.Flags().GetBool("prompts")
+		}
+
+		if err := saveReminderConfig(cfg); err != nil {
+			fmt.Printf("❌ Error saving settings: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("✅ Reminder settings saved")
+	},
+}
+
+var remindPromptCmd = &cobra.Command{
+	Use:   "prompt",
+	Short: "Manage writing prompts",
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadReminderConfig()
+		fmt.Print("\n💭 Writing Prompts:\n\n")
+		for i, prompt := range reminderPrompts(cfg) {
+			fmt.Printf("  %2d. %s\n", i+1, prompt)
+		}
+		fmt.Println()
+	},
+}
+
+var remindPromptAddCmd = &cobra.Command{
+	Use:   "add <prompt>",
+	Short: "Add a writing prompt",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadReminderConfig()
+		if len(cfg.Prompts) == 0 {
+			cfg.Prompts = append([]string{}, defaultPrompts...)
+		}
+		cfg.Prompts = append(cfg.Prompts, strings.TrimSpace(args[0]))
+
+		if err := saveReminderConfig(cfg); err != nil {
+			fmt.Printf("❌ Error saving prompt: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Added prompt #%d\n", len(cfg.Prompts))
+	},
+}
+
+var remindPromptRemoveCmd = &cobra.Command{
+	Use:   "rm <n>",
+	Short: "Remove a writing prompt by number",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadReminderConfig()
+		if len(cfg.Prompts) == 0 {
+			cfg.Prompts = append([]string{}, defaultPrompts...)
+		}
+
+		index, err := strconv.Atoi(args[0])
+		if err != nil || index < 1 || index > len(cfg.Prompts) {
+			fmt.Printf("❌ Invalid prompt number '%s'\n", args[0])
+			os.Exit(1)
+		}
+		if len(cfg.Prompts) == 1 {
+			fmt.Println("❌ Keep at least one prompt, or turn prompts off with 'notetype remind set --prompts=false'")
+			os.Exit(1)
+		}
+		cfg.Prompts = append(cfg.Prompts[:index-1], cfg.Prompts[index:]...)
+
+		if err := saveReminderConfig(cfg); err != nil {
+			fmt.Printf("❌ Error saving prompts: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Removed prompt #%d\n", index)
+	},
+}
+
+func init() {
+	remindCheckCmd.Flags().BoolP("notify", "n", false, "Also show a desktop notification (notify-send)")
+	remindInstallCmd.Flags().Bool("systemd", false, "Install a systemd user timer instead of printing a crontab line")
+	remindSetCmd.Flags().Int("hour", defaultReminderConfig.Hour, "Hour of the day (0-23) after which to nudge")
+	remindSetCmd.Flags().Bool("prompts", true, "Add a rotating writing prompt to new journal entries")
+
+	remindPromptCmd.AddCommand(remindPromptAddCmd)
+	remindPromptCmd.AddCommand(remindPromptRemoveCmd)
+	remindCmd.AddCommand(remindCheckCmd)
+	remindCmd.AddCommand(remindInstallCmd)
+	remindCmd.AddCommand(remindSetCmd)
+	remindCmd.AddCommand(remindPromptCmd)
+	rootCmd.AddCommand(remindCmd)
+}
@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+)
+
+// maxIncludeDepth stops templates that include each other from recursing forever
+const maxIncludeDepth = 5
+
+var dateOffsetRe = regexp.MustCompile(`^([+-]\d+)([dwmy])$`)
+
+// looseOffsetRe matches anything written like an offset, so typos such as
+// "+1x" are reported instead of being used as a layout
+var looseOffsetRe = regexp.MustCompile(`^[+-]\d+[a-zA-Z]+$`)
+
+// templateContext holds everything a template can refer to while rendering
+type templateContext struct {
+	Now     time.Time
+	Title   string
+	Answers map[string]string
+}
+
+// templateData is the value of "." inside a template, for use in conditionals
+// such as {{if .Title}}
+type templateData struct {
+	Title    string
+	Date     string
+	Datetime string
+	Time     string
+	Year     string
+	Month    string
+	Day      string
+	Answers  map[string]string
+}
+
+// shiftDate applies an offset such as "+1d", "-2w", "+1m" or "+1y" to a time
+func shiftDate(t time.Time, offset string) (time.Time, bool) {
+	match := dateOffsetRe.FindStringSubmatch(offset)
+	if match == nil {
+		return t, false
+	}
+
+	n, _ := strconv.Atoi(match[1])
+	switch match[2] {
+	case "d":
+		return t.AddDate(0, 0, n), true
+	case "w":
+		return t.AddDate(0, 0, 7*n), true
+	case "m":
+		return t.AddDate(0, n, 0), true
+	default:
+		return t.AddDate(n, 0, 0), true
+	}
+}
+
+// slugify turns text into a lowercase, dash-separated file name part
+func slugify(text string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+	return b.String()
+}
+
+// templateFuncs returns the functions available to templates. Prompt names are
+// recorded in asked so callers can find out which answers a template needs.
+func templateFuncs(ctx templateContext, depth int, asked *[]string) template.FuncMap {
+	return template.FuncMap{
+		// {{date}}, {{date "+1d"}}, {{date "Monday"}} or {{date "-1w" "Jan 2"}}
+		"date": func(args ...string) (string, error) {
+			t, layout := ctx.Now, "2006-01-02"
+			for _, arg := range args {
+				if shifted, ok := shiftDate(t, arg); ok {
+					t = shifted
+				} else if looseOffsetRe.MatchString(arg) {
+					return "", fmt.Errorf("date offset '%s' not understood; use +/-N with d, w, m or y", arg)
+				} else {
+					layout = arg
+				}
+			}
+			return t.Format(layout), nil
+		},
+		"datetime": func() string { return ctx.Now.Format("2006-01-02 15:04") },
+		"time":     func() string { return ctx.Now.Format("15:04") },
+		"year":     func() string { return ctx.Now.Format("2006") },
+		"month":    func() string { return ctx.Now.Format("January") },
+		"day":      func() string { return ctx.Now.Format("Monday") },
+		"title":    func() string { return ctx.Title },
+		"upper":    strings.ToUpper,
+		"lower":    strings.ToLower,
+		"list":     func(items ...string) []string { return items },
+		"slug":     slugify,
+
+		// {{prompt "Attendees"}} or {{prompt "Status" "Planning"}} with a default
+		"prompt": func(name string, defaults ...string) string {
+			if asked != nil {
+				seen := false
+				for _, existing := range *asked {
+					if existing == name {
+						seen = true
+						break
+					}
+				}
+				if !seen {
+					*asked = append(*asked, name)
+				}
+			}
+			if answer := ctx.Answers[name]; answer != "" {
+				return answer
+			}
+			if len(defaults) > 0 {
+				return defaults[0]
+			}
+			return ""
+		},
+
+		// {{include "other-template"}}
+		"include": func(name string) (string, error) {
+			if depth >= maxIncludeDepth {
+				return "", fmt.Errorf("templates included too deeply (max %d)", maxIncludeDepth)
+			}
+			content, err := getTemplateContent(name)
+			if err != nil {
+				return "", err
+			}
+			return executeTemplate(name, content, ctx, depth+1, asked)
+		},
+	}
+}
+
+// executeTemplate parses and runs a template with the given context
+func executeTemplate(name, content string, ctx templateContext, depth int, asked *[]string) (string, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs(ctx, depth, asked)).Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("error parsing template: %v", err)
+	}
+
+	data := templateData{
+		Title:    ctx.Title,
+		Date:     ctx.Now.Format("2006-01-02"),
+		Datetime: ctx.Now.Format("2006-01-02 15:04"),
+		Time:     ctx.Now.Format("15:04"),
+		Year:     ctx.Now.Format("2006"),
+		Month:    ctx.Now.Format("January"),
+		Day:      ctx.Now.Format("Monday"),
+		Answers:  ctx.Answers,
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("error rendering template: %v", err)
+	}
+	return out.String(), nil
+}
+
+// renderTemplate renders template content with Go's text/template engine
+func renderTemplate(content string, ctx templateContext) (string, error) {
+	return executeTemplate("note", content, ctx, 0, nil)
+}
+
+// templatePrompts returns the names of every {{prompt}} in a template,
+// including those in included templates, in the order they appear
+func templatePrompts(content string) ([]string, error) {
+	var asked []string
+	ctx := templateContext{Now: time.Now()}
+	if _, err := executeTemplate("note", content, ctx, 0, &asked); err != nil {
+		return nil, err
+	}
+	return asked, nil
+}
+
+// askTemplatePrompts asks for every prompt that doesn't have an answer yet
+func askTemplatePrompts(prompts []string, answers map[string]string, in io.Reader) map[string]string {
+	if answers == nil {
+		answers = make(map[string]string)
+	}
+
+	reader := bufio.NewReader(in)
+	for _, name := range prompts {
+		if _, answered := answers[name]; answered {
+			continue
+		}
+		fmt.Printf("✏️  %s: ", name)
+		line, _ := reader.ReadString('\n')
+		answers[name] = strings.TrimSpace(line)
+	}
+	return answers
+}
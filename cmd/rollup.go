@@ -0,0 +1,328 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var checkboxRe = regexp.MustCompile(`^\s*[-*]\s+\[([ xX])\]\s+(.+)$`)
+
+// journalDay is a single daily journal file that falls inside a rollup period
+type journalDay struct {
+	date    time.Time
+	path    string
+	content string
+}
+
+// checkboxItem is a task line found in a daily entry
+type checkboxItem struct {
+	text string
+	done bool
+	day  journalDay
+}
+
+// rollupPeriod returns the first and last day of the week or month containing date
+func rollupPeriod(date time.Time, monthly bool) (time.Time, time.Time) {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+
+	if monthly {
+		start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+		return start, start.AddDate(0, 1, -1)
+	}
+
+	// Weeks start on Monday
+	offset := (int(date.Weekday()) + 6) % 7
+	start := date.AddDate(0, 0, -offset)
+	return start, start.AddDate(0, 0, 6)
+}
+
+// loadJournalDays reads every daily entry between start and end (inclusive)
+func loadJournalDays(start, end time.Time) ([]journalDay, error) {
+	journalDir := getJournalDir()
+
+	var days []journalDay
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		path := filepath.Join(journalDir, d.Format("2006-01-02")+".md")
+		content, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %v", path, err)
+		}
+		days = append(days, journalDay{date: d, path: path, content: string(content)})
+	}
+
+	return days, nil
+}
+
+// dayLink renders a Markdown link back to a daily entry
+func dayLink(day journalDay) string {
+	return fmt.Sprintf("[%s](%s)", day.date.Format("Mon, Jan 2"), day.path)
+}
+
+// dayBody strips the title and date headings that every daily entry starts with
+// and demotes the remaining headings so they nest under the day's heading
+func dayBody(content string) []string {
+	var body []string
+	var fence codeFence
+
+	for _, line := range strings.Split(content, "\n") {
+		if !fence.step(line) && !fence.open() {
+			if level, _, ok := parseHeading(line); ok {
+				if level <= 2 {
+					continue
+				}
+				if level < 6 {
+					line = "#" + line
+				}
+			}
+		}
+		body = append(body, line)
+	}
+
+	return body
+}
+
+// summarizeDay keeps the first line of text under each heading of a day
+func summarizeDay(content string) []string {
+	var summary []string
+	var heading string
+	captured := false
+
+	for _, line := range dayBody(content) {
+		trimmed := strings.TrimSpace(line)
+		if _, text, ok := parseHeading(trimmed); ok {
+			heading = text
+			captured = false
+			continue
+		}
+		if captured || trimmed == "" || trimmed == "---" || checkboxRe.MatchString(line) {
+			continue
+		}
+
+		if heading != "" {
+			summary = append(summary, fmt.Sprintf("- **%s** %s", heading, trimmed))
+		} else {
+			summary = append(summary, "- "+trimmed)
+		}
+		captured = true
+	}
+
+	return summary
+}
+
+// collectCheckboxes returns every non-empty checkbox item in a day, leaving
+// out examples inside code fences
+func collectCheckboxes(day journalDay) []checkboxItem {
+	var items []checkboxItem
+	var fence codeFence
+	for _, line := range strings.Split(day.content, "\n") {
+		if fence.step(line) {
+			continue
+		}
+		if fence.open() {
+			continue
+		}
+		match := checkboxRe.FindStringSubmatch(line)
+		if match == nil || strings.TrimSpace(match[2]) == "" {
+			continue
+		}
+		items = append(items, checkboxItem{
+			text: strings.TrimSpace(match[2]),
+			done: match[1] != " ",
+			day:  day,
+		})
+	}
+	return items
+}
+
+// buildRollup renders the weekly template pre-filled from the given days
+func buildRollup(days []journalDay, start, end time.Time, monthly, summarize bool) (string, error) {
+	content, err := renderRollupTemplate(start)
+	if err != nil {
+		return "", err
+	}
+
+	if monthly {
+		lines := strings.SplitN(content, "\n", 2)
+		lines[0] = "# Monthly Review - " + start.Format("January 2006")
+		content = strings.Join(lines, "\n")
+	}
+
+	// Overview: period, tags and each day's entries
+	tagSet := make(map[string]bool)
+	var tags []string
+	var overview []string
+	overview = append(overview, fmt.Sprintf("**Period:** %s → %s (%d entries)",
+		start.Format("2006-01-02"), end.Format("2006-01-02"), len(days)))
+
+	var checkboxes []checkboxItem
+	for _, day := range days {
+		for _, tag := range extractTags(day.content) {
+			if !tagSet[tag] {
+				tagSet[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+		checkboxes = append(checkboxes, collectCheckboxes(day)...)
+	}
+
+	if len(tags) > 0 {
+		overview = append(overview, "**Tags:** #"+strings.Join(tags, " #"))
+	}
+
+	for _, day := range days {
+		overview = append(overview, "", "### "+dayLink(day), "")
+		if summarize {
+			overview = append(overview, summarizeDay(day.content)...)
+		} else {
+			overview = append(overview, strings.Split(strings.TrimSpace(strings.Join(dayBody(day.content), "\n")), "\n")...)
+		}
+	}
+
+	content = fillSection(content, "📊 Overview", strings.Join(overview, "\n"))
+
+	// Wins: completed tasks, Challenges: tasks still open
+	var wins, challenges []string
+	for _, item := range checkboxes {
+		line := fmt.Sprintf("- %s (%s)", item.text, dayLink(item.day))
+		if item.done {
+			wins = append(wins, line)
+		} else {
+			challenges = append(challenges, "- [ ] "+strings.TrimPrefix(line, "- "))
+		}
+	}
+
+	if len(wins) == 0 {
+		for _, day := range days {
+			wins = append(wins, "- "+dayLink(day)+": ")
+		}
+	}
+
+	content = fillSection(content, "✅ Wins", strings.Join(wins, "\n"))
+	if len(challenges) > 0 {
+		content = fillSection(content, "🤔 Challenges", strings.Join(challenges, "\n"))
+	}
+
+	return content, nil
+}
+
+// fillSection replaces the body of a section, adding the section at the end
+// when an overridden weekly template doesn't have it
+func fillSection(content, heading, body string) string {
+	if filled, err := replaceSectionBody(content, heading, body); err == nil {
+		return filled
+	}
+	return strings.TrimRight(content, "\n") + "\n\n## " + heading + "\n\n" + body + "\n"
+}
+
+// renderRollupTemplate renders the weekly template for the start of the period
+func renderRollupTemplate(start time.Time) (string, error) {
+	templateContent, err := getTemplateContent("weekly")
+	if err != nil {
+		return "", err
+	}
+
+	return renderTemplate(templateContent, templateContext{Now: start})
+}
+
+// createRollup writes a weekly or monthly rollup note for the period containing date
+func createRollup(date time.Time, monthly, summarize bool, output string, force bool) error {
+	start, end := rollupPeriod(date, monthly)
+
+	days, err := loadJournalDays(start, end)
+	if err != nil {
+		return err
+	}
+	if len(days) == 0 {
+		return fmt.Errorf("no journal entries between %s and %s",
+			start.Format("2006-01-02"), end.Format("2006-01-02"))
+	}
+
+	content, err := buildRollup(days, start, end, monthly, summarize)
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		if monthly {
+			output = "monthly-" + start.Format("2006-01")
+		} else {
+			output = "weekly-" + start.Format("2006-01-02")
+		}
+	}
+	filePath := output + ".md"
+
+	if _, err := os.Stat(filePath); err == nil && !force {
+		return fmt.Errorf("'%s' already exists. Use --force to overwrite it", filePath)
+	}
+
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("error creating file: %v", err)
+	}
+
+	fmt.Printf("\n✅ Rolled up %d journal entries into '%s'\n", len(days), filePath)
+	return nil
+}
+
+var journalRollupCmd = &cobra.Command{
+	Use:   "rollup [date]",
+	Short: "Generate a weekly or monthly review from daily entries",
+	Long: `Rollup collects the daily journal entries of a week or month into a single
+note based on the 'weekly' template.
+
+Each day's sections are concatenated (or summarized with --summarize), tags
+are collected, completed checkboxes are listed under Wins and open ones
+under Challenges, each with a link back to its day.
+
+Examples:
+  notetype journal rollup --week               # This week
+  notetype journal rollup --month 2025-01-15   # January 2025
+  notetype journal rollup --week --summarize -o review
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		date := time.Now()
+		if len(args) > 0 {
+			parsed, err := time.ParseInLocation("2006-01-02", args[0], time.Local)
+			if err != nil {
+				fmt.Printf("❌ Invalid date '%s' (expected YYYY-MM-DD)\n", args[0])
+				os.Exit(1)
+			}
+			date = parsed
+		}
+
+		weekly, _ := cmd.Flags().GetBool("week")
+		monthly, _ := cmd.Flags().GetBool("month")
+		summarize, _ := cmd.Flags().GetBool("summarize")
+		output, _ := cmd.Flags().GetString("output")
+		force, _ := cmd.Flags().GetBool("force")
+
+		if weekly && monthly {
+			fmt.Println("❌ Use either --week or --month, not both")
+			os.Exit(1)
+		}
+
+		if err := createRollup(date, monthly, summarize, output, force); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	journalRollupCmd.Flags().BoolP("week", "w", false, "Roll up the week containing the date (default)")
+	journalRollupCmd.Flags().BoolP("month", "m", false, "Roll up the month containing the date")
+	journalRollupCmd.Flags().BoolP("summarize", "s", false, "Keep only the first line of each section")
+	journalRollupCmd.Flags().StringP("output", "o", "", "Output filename (default weekly-<date> or monthly-<month>)")
+	journalRollupCmd.Flags().BoolP("force", "f", false, "Overwrite an existing rollup note")
+
+	journalCmd.AddCommand(journalRollupCmd)
+}
@@ -29,9 +29,15 @@ Use CLI commands for scripting and automation.
 
 CLI Commands:
   journal - Daily journaling
+  remind  - Journal reminders and writing prompts
+  recur   - Create notes from templates on a schedule
   new     - Create a new note
   update  - Append content to an existing note
+  mv      - Rename or move a note
   remove  - Delete a note
+  pin     - Pin notes to the TUI main menu
+  recent  - Recently opened notes
+  notebooks - Folders of notes
   list    - List all notes
   view    - View the contents of a note
   search  - Search for notes by title or content
@@ -56,8 +62,21 @@ func init() {
 
 // launchTUI starts the TUI interface
 func launchTUI() {
+	runTUI(initialTUIModel())
+}
+
+// launchTUIEditor starts the TUI with a note open in the editor
+func launchTUIEditor(note string) {
+	m := initialTUIModel()
+	m.currentNote = note
+	next, _ := m.editCurrentNote()
+	runTUI(next)
+}
+
+// runTUI runs the TUI from the given starting model
+func runTUI(m tea.Model) {
 	p := tea.NewProgram(
-		initialTUIModel(),
+		m,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	)
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// searchMatch is a line of a note or journal day containing the query
+type searchMatch struct {
+	line int
+	text string
+}
+
+// searchFile returns the lines of content containing query, ignoring case
+func searchFile(content, query string) []searchMatch {
+	query = strings.ToLower(query)
+
+	var matches []searchMatch
+	for i, line := range strings.Split(content, "\n") {
+		if strings.Contains(strings.ToLower(line), query) {
+			matches = append(matches, searchMatch{line: i + 1, text: strings.TrimSpace(line)})
+		}
+	}
+	return matches
+}
+
+// searchCmd searches notes and journals
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Search notes and journals by title or content",
+	Args:  cobra.ExactArgs(1),
+	Long: `Search every note and journal day for text, ignoring case. Each match
+is shown with its line number.
+
+Examples:
+  notetype search roadmap
+  notetype search "design review" --notebook work
+  notetype search standup --journal work
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		notebook, _ := cmd.Flags().GetString("notebook")
+		journal, _ := cmd.Flags().GetString("journal")
+
+		if err := checkNotebook(notebook); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		if _, exists := getJournalConfig(journal); journal != "" && !exists {
+			fmt.Printf("❌ Journal '%s' not found\n", journal)
+			os.Exit(1)
+		}
+
+		total, found := 0, 0
+		for _, file := range filesInScope(journal, notebook) {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				continue
+			}
+			matches := searchFile(string(content), args[0])
+			if len(matches) == 0 {
+				continue
+			}
+
+			fmt.Printf("📄 %s\n", file)
+			for _, match := range matches {
+				fmt.Printf("  %4d: %s\n", match.line, match.text)
+			}
+			fmt.Println()
+			total += len(matches)
+			found++
+		}
+
+		if found == 0 {
+			fmt.Printf("🔍 Nothing found for '%s'\n", args[0])
+			return
+		}
+		fmt.Printf("🔍 %d match(es) in %d file(s)\n", total, found)
+	},
+}
+
+func init() {
+	searchCmd.Flags().StringP("notebook", "b", "", "Only search notes in this notebook")
+	searchCmd.Flags().StringP("journal", "j", "", "Only search this journal")
+	rootCmd.AddCommand(searchCmd)
+}
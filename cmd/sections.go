@@ -0,0 +1,332 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var headingRe = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*$`)
+
+// thematicBreakRe matches a horizontal rule such as "---" or "***"
+var thematicBreakRe = regexp.MustCompile(`^ {0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+
+// markdownSection describes a heading and the lines that belong to it,
+// up to the next heading of the same or a higher level.
+type markdownSection struct {
+	level   int
+	heading string
+	start   int // line index of the heading itself
+	end     int // line index one past the last line of the section
+}
+
+// parseHeading reports the level and text of an ATX heading line
+func parseHeading(line string) (int, string, bool) {
+	match := headingRe.FindStringSubmatch(line)
+	if match == nil {
+		return 0, "", false
+	}
+	return len(match[1]), match[2], true
+}
+
+// codeFence follows fenced code blocks line by line. A block opened with
+// ``` or ~~~ only closes on a fence of the same character that is at least
+// as long, so a ``` line inside a ~~~ block is just code.
+type codeFence struct {
+	char byte // fence character of the open block, 0 outside one
+	size int
+}
+
+// fenceRun returns the fence character and length a line starts with, and
+// the text after the fence
+func fenceRun(line string) (byte, int, string) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || (trimmed[0] != '`' && trimmed[0] != '~') {
+		return 0, 0, ""
+	}
+	n := 0
+	for n < len(trimmed) && trimmed[n] == trimmed[0] {
+		n++
+	}
+	if n < 3 {
+		return 0, 0, ""
+	}
+	return trimmed[0], n, trimmed[n:]
+}
+
+// step feeds the next line to the fence, reporting whether it opened or
+// closed a block
+func (f *codeFence) step(line string) bool {
+	char, size, rest := fenceRun(line)
+	if char == 0 {
+		return false
+	}
+	if f.char == 0 {
+		// A backtick fence's info string can't itself hold backticks
+		if char == '`' && strings.Contains(rest, "`") {
+			return false
+		}
+		f.char, f.size = char, size
+		return true
+	}
+	if char == f.char && size >= f.size && strings.TrimSpace(rest) == "" {
+		f.char, f.size = 0, 0
+		return true
+	}
+	return false
+}
+
+// open reports whether the lines fed so far leave a block open
+func (f codeFence) open() bool {
+	return f.char != 0
+}
+
+// parseSections returns every heading section in the given lines,
+// ignoring anything that looks like a heading inside a code fence. A
+// tag footer (a horizontal rule followed only by lines of #tags, as
+// templates add) never belongs to the last section; other rules are
+// ordinary section content.
+func parseSections(lines []string) []markdownSection {
+	var sections []markdownSection
+	footer := len(lines)
+	var fence codeFence
+
+	for i, line := range lines {
+		if fence.step(line) {
+			continue
+		}
+		if fence.open() {
+			continue
+		}
+		if thematicBreakRe.MatchString(line) && isTagFooter(lines[i+1:]) {
+			footer = i
+			break
+		}
+		level, text, ok := parseHeading(line)
+		if !ok {
+			continue
+		}
+		sections = append(sections, markdownSection{level: level, heading: text, start: i})
+	}
+
+	// A section ends where the next heading of the same or higher level
+	// starts, or at the tag footer
+	for i := range sections {
+		sections[i].end = footer
+		for j := i + 1; j < len(sections); j++ {
+			if sections[j].level <= sections[i].level {
+				sections[i].end = sections[j].start
+				break
+			}
+		}
+	}
+
+	return sections
+}
+
+// isTagFooter reports whether lines hold nothing but #tags and blank lines,
+// with at least one tag
+func isTagFooter(lines []string) bool {
+	tagged := false
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if !isTagLine(line) {
+			return false
+		}
+		tagged = true
+	}
+	return tagged
+}
+
+// normalizeHeading strips decorations such as emoji and trailing colons so
+// that "## ✅ Wins" matches a lookup for "wins"
+func normalizeHeading(heading string) string {
+	heading = strings.TrimLeftFunc(heading, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	heading = strings.TrimRight(heading, ": ")
+	return strings.ToLower(strings.TrimSpace(heading))
+}
+
+// findSection looks up the first section whose heading matches name
+func findSection(lines []string, name string) (markdownSection, bool) {
+	target := normalizeHeading(name)
+	for _, section := range parseSections(lines) {
+		if normalizeHeading(section.heading) == target {
+			return section, true
+		}
+	}
+	return markdownSection{}, false
+}
+
+// replaceSectionBody swaps everything below a heading for the given body
+func replaceSectionBody(content, name, body string) (string, error) {
+	lines := strings.Split(content, "\n")
+	section, ok := findSection(lines, name)
+	if !ok {
+		return "", sectionNotFoundError(lines, name)
+	}
+
+	replacement := []string{lines[section.start], ""}
+	if strings.TrimSpace(body) != "" {
+		replacement = append(replacement, strings.Split(strings.TrimRight(body, "\n"), "\n")...)
+		replacement = append(replacement, "")
+	}
+
+	var result []string
+	result = append(result, lines[:section.start]...)
+	result = append(result, replacement...)
+	result = append(result, lines[section.end:]...)
+	return strings.Join(result, "\n"), nil
+}
+
+// listItemKind returns "-" for a bullet item, "- [ ]" for a checkbox item
+// and "" for anything else
+func listItemKind(line string) string {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "-") && !strings.HasPrefix(trimmed, "*") {
+		return ""
+	}
+	rest := strings.TrimSpace(trimmed[1:])
+	if trimmed != "-" && trimmed != "*" && !strings.HasPrefix(trimmed[1:], " ") {
+		return ""
+	}
+	if strings.HasPrefix(rest, "[ ]") || strings.HasPrefix(strings.ToLower(rest), "[x]") {
+		return "- [ ]"
+	}
+	return "-"
+}
+
+// isPlaceholderItem reports whether a line is an empty list item such as
+// "- " or "- [ ] " left by a template to be filled in
+func isPlaceholderItem(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "-" || trimmed == "*" || trimmed == "- [ ]" || trimmed == "* [ ]"
+}
+
+// insertIntoSection adds body to a section, at its end or, with atTop,
+// right below its heading. List items fill the section's empty placeholder
+// items first so template structure stays intact.
+func insertIntoSection(content, name, body string, atTop bool) (string, error) {
+	lines := strings.Split(content, "\n")
+	section, ok := findSection(lines, name)
+	if !ok {
+		return "", sectionNotFoundError(lines, name)
+	}
+
+	var insert []string
+	for _, line := range strings.Split(strings.TrimRight(body, "\n"), "\n") {
+		filled := false
+		if kind := listItemKind(line); kind != "" {
+			for i := section.start + 1; i < section.end; i++ {
+				if isPlaceholderItem(lines[i]) && listItemKind(lines[i]) == kind {
+					lines[i] = line
+					filled = true
+					break
+				}
+			}
+		}
+		if !filled {
+			insert = append(insert, line)
+		}
+	}
+	if len(insert) == 0 {
+		return strings.Join(lines, "\n"), nil
+	}
+
+	// Keep a blank line between the heading and the section's content
+	at := section.start + 1
+	if atTop {
+		if at < section.end && strings.TrimSpace(lines[at]) == "" {
+			at++
+		}
+	} else {
+		at = section.end
+		for at > section.start+1 && strings.TrimSpace(lines[at-1]) == "" {
+			at--
+		}
+	}
+	switch {
+	case atTop:
+	case at == section.start+1:
+		insert = append([]string{""}, insert...)
+	case strings.TrimSpace(lines[at-1]) != "" && (listItemKind(lines[at-1]) == "" || listItemKind(insert[0]) == ""):
+		// Keep the inserted lines apart from the text above, unless both
+		// are items of the same list
+		insert = append([]string{""}, insert...)
+	}
+	// Keep the inserted lines apart from what follows, unless both are
+	// items of the same list
+	if at < len(lines) && strings.TrimSpace(lines[at]) != "" {
+		_, _, heading := parseHeading(lines[at])
+		sameList := listItemKind(lines[at]) != "" && listItemKind(insert[len(insert)-1]) != ""
+		if heading || thematicBreakRe.MatchString(lines[at]) || !sameList {
+			insert = append(insert, "")
+		}
+	}
+
+	var result []string
+	result = append(result, lines[:at]...)
+	result = append(result, insert...)
+	result = append(result, lines[at:]...)
+	return strings.Join(result, "\n"), nil
+}
+
+// sectionNotFoundError names the sections a note does have
+func sectionNotFoundError(lines []string, name string) error {
+	var headings []string
+	for _, section := range parseSections(lines) {
+		headings = append(headings, section.heading)
+	}
+	if len(headings) == 0 {
+		return fmt.Errorf("section '%s' not found: the note has no headings", name)
+	}
+	return fmt.Errorf("section '%s' not found (sections: %s)", name, strings.Join(headings, ", "))
+}
+
+// noteBodyStart returns the first line after a note's front matter and its
+// title block (the "# Title", date and '---' lines 'notetype new' writes)
+func noteBodyStart(lines []string) int {
+	i := 0
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == "---" {
+		for j := 1; j < len(lines); j++ {
+			if strings.TrimSpace(lines[j]) == "---" {
+				i = j + 1
+				break
+			}
+		}
+	}
+
+	if i < len(lines) {
+		if level, _, ok := parseHeading(lines[i]); ok && level == 1 {
+			i++
+			if i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "<span") {
+				i++
+			}
+			if i < len(lines) && strings.TrimSpace(lines[i]) == "---" {
+				i++
+			}
+		}
+	}
+	return i
+}
+
+// prependToNote adds body to the top of a note, below its title block
+func prependToNote(content, body string) string {
+	lines := strings.Split(content, "\n")
+	at := noteBodyStart(lines)
+
+	insert := strings.Split(strings.TrimRight(body, "\n"), "\n")
+	if at < len(lines) && strings.TrimSpace(lines[at]) != "" {
+		insert = append(insert, "")
+	}
+
+	var result []string
+	result = append(result, lines[:at]...)
+	result = append(result, insert...)
+	result = append(result, lines[at:]...)
+	return strings.Join(result, "\n")
+}
@@ -0,0 +1,374 @@
+package cmd
+
+import (
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+var (
+	markdownTagRe        = regexp.MustCompile(`(^|\s)(#[\w/-]+)`)
+	markdownInlineCodeRe = regexp.MustCompile("`[^`]+`")
+)
+
+// Styles holds every TUI style, built from a single theme so that switching
+// themes restyles all components the same way
+type Styles struct {
+	Theme Theme
+
+	// Renderer draws every style, so a color profile forced for NO_COLOR
+	// stays with these styles instead of changing lipgloss globally
+	Renderer *lipgloss.Renderer
+
+	// Color palette
+	Primary       lipgloss.Color
+	Secondary     lipgloss.Color
+	Accent        lipgloss.Color
+	Success       lipgloss.Color
+	Warning       lipgloss.Color
+	Error         lipgloss.Color
+	Text          lipgloss.Color
+	Muted         lipgloss.Color
+	Background    lipgloss.Color
+	BackgroundAlt lipgloss.Color
+	OnPrimary     lipgloss.Color
+
+	// Screen chrome
+	Title      lipgloss.Style
+	StatusBar  lipgloss.Style
+	StatusMode lipgloss.Style
+	Status     lipgloss.Style
+	Help       lipgloss.Style
+	HelpBox    lipgloss.Style
+	Header     lipgloss.Style
+
+	// Text
+	SelectedItem lipgloss.Style
+	NormalItem   lipgloss.Style
+	MutedText    lipgloss.Style
+	SuccessText  lipgloss.Style
+	WarningText  lipgloss.Style
+	ErrorText    lipgloss.Style
+
+	// Boxes
+	Panel       lipgloss.Style
+	ActivePanel lipgloss.Style
+	Editor      lipgloss.Style
+	Viewer      lipgloss.Style
+	Dialog      lipgloss.Style
+
+	// Buttons
+	ActiveButton   lipgloss.Style
+	InactiveButton lipgloss.Style
+	Chip           lipgloss.Style
+
+	// Bubbles components
+	List          list.Styles
+	ListItems     list.DefaultItemStyles
+	EditorFocused textarea.Style
+	EditorBlurred textarea.Style
+	InputPrompt   lipgloss.Style
+	InputText     lipgloss.Style
+	InputHint     lipgloss.Style
+
+	// Markdown in the viewer
+	MarkdownHeading    lipgloss.Style
+	MarkdownSubheading lipgloss.Style
+	MarkdownQuote      lipgloss.Style
+	MarkdownCode       lipgloss.Style
+	MarkdownTag        lipgloss.Style
+	MarkdownTask       lipgloss.Style
+	MarkdownDoneTask   lipgloss.Style
+	MarkdownRule       lipgloss.Style
+}
+
+// NewStyles builds the TUI styles for a theme. With NO_COLOR every color is
+// left unset, but bold, underline and reverse video still render so
+// highlights stay visible.
+func NewStyles(theme Theme) Styles {
+	r := lipgloss.NewRenderer(os.Stdout)
+	mono := noColor()
+	if mono {
+		theme = Theme{Name: theme.Name}
+		r.SetColorProfile(termenv.ANSI)
+	}
+
+	s := Styles{
+		Theme:         theme,
+		Renderer:      r,
+		Primary:       lipgloss.Color(theme.Primary),
+		Secondary:     lipgloss.Color(theme.Secondary),
+		Accent:        lipgloss.Color(theme.Accent),
+		Success:       lipgloss.Color(theme.Success),
+		Warning:       lipgloss.Color(theme.Warning),
+		Error:         lipgloss.Color(theme.Error),
+		Text:          lipgloss.Color(theme.Text),
+		Muted:         lipgloss.Color(theme.Muted),
+		Background:    lipgloss.Color(theme.Background),
+		BackgroundAlt: lipgloss.Color(theme.BackgroundAlt),
+	}
+
+	// Text on the primary color uses whichever theme color reads best on it
+	if theme.Primary != "" {
+		s.OnPrimary = contrastColor(theme.Primary, theme.Text, theme.Background)
+	}
+
+	s.Title = r.NewStyle().
+		Foreground(s.OnPrimary).
+		Background(s.Primary).
+		Bold(true).
+		Padding(0, 2).
+		MarginBottom(1)
+
+	s.StatusBar = r.NewStyle().
+		Foreground(s.Text).
+		Background(s.BackgroundAlt).
+		Padding(0, 1)
+
+	s.StatusMode = r.NewStyle().
+		Foreground(s.Accent).
+		Bold(true)
+
+	s.Status = r.NewStyle().
+		Foreground(s.Muted).
+		Italic(true)
+
+	s.Help = r.NewStyle().
+		Foreground(s.Muted).
+		MarginTop(1)
+
+	s.HelpBox = s.Help.
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(s.Muted).
+		Padding(1, 2)
+
+	s.Header = r.NewStyle().
+		Foreground(s.Accent).
+		Bold(true).
+		MarginBottom(1)
+
+	s.SelectedItem = r.NewStyle().
+		Foreground(s.Primary).
+		Bold(true).
+		PaddingLeft(2)
+
+	s.NormalItem = r.NewStyle().
+		Foreground(s.Text).
+		PaddingLeft(2)
+
+	s.MutedText = r.NewStyle().Foreground(s.Muted)
+	s.SuccessText = r.NewStyle().Foreground(s.Success)
+	s.WarningText = r.NewStyle().Foreground(s.Warning)
+	s.ErrorText = r.NewStyle().Foreground(s.Error)
+
+	s.Panel = r.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(s.Primary).
+		Padding(1, 2).
+		MarginRight(2)
+
+	s.ActivePanel = s.Panel.BorderForeground(s.Accent)
+
+	s.Editor = r.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(s.Secondary).
+		Padding(1)
+
+	s.Viewer = s.Panel
+
+	s.Dialog = r.NewStyle().
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(s.Accent).
+		Padding(1, 2)
+
+	s.ActiveButton = r.NewStyle().
+		Foreground(s.OnPrimary).
+		Background(s.Primary).
+		Bold(true).
+		Padding(0, 3).
+		MarginRight(2)
+
+	s.InactiveButton = r.NewStyle().
+		Foreground(s.Muted).
+		Background(s.BackgroundAlt).
+		Padding(0, 3).
+		MarginRight(2)
+
+	s.Chip = r.NewStyle().
+		Foreground(s.OnPrimary).
+		Background(s.Accent).
+		Padding(0, 1).
+		MarginRight(1)
+
+	// Lists
+	s.List = list.DefaultStyles()
+	s.List.StatusBar = s.List.StatusBar.Renderer(r)
+	s.List.HelpStyle = s.List.HelpStyle.Renderer(r)
+	s.List.ActivePaginationDot = s.List.ActivePaginationDot.Renderer(r)
+	s.List.InactivePaginationDot = s.List.InactivePaginationDot.Renderer(r)
+	s.List.ArabicPagination = s.List.ArabicPagination.Renderer(r)
+	s.List.DividerDot = s.List.DividerDot.Renderer(r)
+	s.List.Title = s.Title.MarginBottom(0)
+	s.List.FilterPrompt = r.NewStyle().Foreground(s.Accent)
+	s.List.FilterCursor = r.NewStyle().Foreground(s.Primary)
+	s.List.DefaultFilterCharacterMatch = r.NewStyle().Underline(true)
+	s.List.StatusBar = s.List.StatusBar.Foreground(s.Muted)
+	s.List.StatusEmpty = r.NewStyle().Foreground(s.Muted)
+	s.List.StatusBarActiveFilter = r.NewStyle().Foreground(s.Text)
+	s.List.StatusBarFilterCount = r.NewStyle().Foreground(s.Muted)
+	s.List.NoItems = r.NewStyle().Foreground(s.Muted)
+	s.List.HelpStyle = s.List.HelpStyle.Foreground(s.Muted)
+	s.List.ActivePaginationDot = s.List.ActivePaginationDot.Foreground(s.Primary)
+	s.List.InactivePaginationDot = s.List.InactivePaginationDot.Foreground(s.Muted)
+	s.List.ArabicPagination = s.List.ArabicPagination.Foreground(s.Muted)
+	s.List.DividerDot = s.List.DividerDot.Foreground(s.Muted)
+
+	s.ListItems = list.NewDefaultItemStyles()
+	for _, style := range []*lipgloss.Style{
+		&s.ListItems.NormalTitle, &s.ListItems.NormalDesc,
+		&s.ListItems.SelectedTitle, &s.ListItems.SelectedDesc,
+		&s.ListItems.DimmedTitle, &s.ListItems.DimmedDesc,
+	} {
+		*style = style.Renderer(r)
+	}
+	s.ListItems.NormalTitle = s.ListItems.NormalTitle.Foreground(s.Text)
+	s.ListItems.NormalDesc = s.ListItems.NormalDesc.Foreground(s.Muted)
+	s.ListItems.SelectedTitle = s.ListItems.SelectedTitle.Foreground(s.Primary).BorderForeground(s.Primary).Bold(true)
+	s.ListItems.SelectedDesc = s.ListItems.SelectedDesc.Foreground(s.Accent).BorderForeground(s.Primary)
+	s.ListItems.DimmedTitle = s.ListItems.DimmedTitle.Foreground(s.Muted)
+	s.ListItems.DimmedDesc = s.ListItems.DimmedDesc.Foreground(s.BackgroundAlt)
+	s.ListItems.FilterMatch = r.NewStyle().Foreground(s.Accent).Underline(true)
+
+	// Editor and inputs
+	s.EditorFocused, s.EditorBlurred = textarea.DefaultStyles()
+	s.EditorFocused.Base = r.NewStyle()
+	s.EditorFocused.CursorLine = r.NewStyle().Background(s.BackgroundAlt)
+	s.EditorFocused.CursorLineNumber = r.NewStyle().Foreground(s.Accent)
+	s.EditorFocused.LineNumber = r.NewStyle().Foreground(s.Muted)
+	s.EditorFocused.EndOfBuffer = r.NewStyle().Foreground(s.BackgroundAlt)
+	s.EditorFocused.Placeholder = r.NewStyle().Foreground(s.Muted)
+	s.EditorFocused.Prompt = r.NewStyle().Foreground(s.Secondary)
+	s.EditorFocused.Text = r.NewStyle().Foreground(s.Text)
+	s.EditorBlurred = s.EditorFocused
+	s.EditorBlurred.CursorLine = r.NewStyle()
+
+	s.InputPrompt = r.NewStyle().Foreground(s.Accent)
+	s.InputText = r.NewStyle().Foreground(s.Text)
+	s.InputHint = r.NewStyle().Foreground(s.Muted)
+
+	// Markdown
+	s.MarkdownHeading = r.NewStyle().Foreground(s.Primary).Bold(true)
+	s.MarkdownSubheading = r.NewStyle().Foreground(s.Accent).Bold(true)
+	s.MarkdownQuote = r.NewStyle().Foreground(s.Muted).Italic(true)
+	s.MarkdownCode = r.NewStyle().Foreground(s.Secondary)
+	s.MarkdownTag = r.NewStyle().Foreground(s.Accent)
+	s.MarkdownTask = r.NewStyle().Foreground(s.Warning)
+	s.MarkdownDoneTask = r.NewStyle().Foreground(s.Success).Strikethrough(true)
+	s.MarkdownRule = r.NewStyle().Foreground(s.Muted)
+
+	if mono {
+		s.Title = s.Title.Reverse(true)
+		s.List.Title = s.List.Title.Reverse(true)
+		s.StatusBar = s.StatusBar.Reverse(true)
+		s.SelectedItem = s.SelectedItem.Underline(true)
+		s.ListItems.SelectedTitle = s.ListItems.SelectedTitle.Underline(true)
+		s.ActiveButton = s.ActiveButton.Reverse(true)
+		s.Chip = s.Chip.Reverse(true)
+		s.EditorFocused.CursorLine = s.EditorFocused.CursorLine.Underline(true)
+	}
+
+	return s
+}
+
+// listDelegate returns a list delegate drawn in these styles
+func (s Styles) listDelegate() list.DefaultDelegate {
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles = s.ListItems
+	return delegate
+}
+
+// styleList applies these styles to a list and its delegate
+func (s Styles) styleList(l *list.Model) {
+	l.Styles = s.List
+	l.SetDelegate(s.listDelegate())
+}
+
+// newList creates a list drawn in these styles
+func (s Styles) newList(items []list.Item, width, height int, title string) list.Model {
+	l := list.New(items, s.listDelegate(), width, height)
+	l.Title = title
+	l.Styles = s.List
+	return l
+}
+
+// styleEditor applies these styles to a textarea
+func (s Styles) styleEditor(ta *textarea.Model) {
+	ta.FocusedStyle = s.EditorFocused
+	ta.BlurredStyle = s.EditorBlurred
+	// The textarea keeps a pointer to its active style, so refresh it
+	if ta.Focused() {
+		ta.Focus()
+	} else {
+		ta.Blur()
+	}
+}
+
+// styleInput applies these styles to a text input
+func (s Styles) styleInput(ti *textinput.Model) {
+	ti.PromptStyle = s.InputPrompt
+	ti.TextStyle = s.InputText
+	ti.PlaceholderStyle = s.InputHint
+	ti.Cursor.Style = s.Renderer.NewStyle().Foreground(s.Primary)
+}
+
+// renderMarkdown styles headings, quotes, code, tasks, rules and #tags for the viewer
+func (s Styles) renderMarkdown(content string) string {
+	lines := strings.Split(content, "\n")
+	var fence codeFence
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if fence.step(line) {
+			lines[i] = s.MarkdownRule.Render(line)
+			continue
+		}
+		if fence.open() {
+			lines[i] = s.MarkdownCode.Render(line)
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(trimmed, "# "):
+			lines[i] = s.MarkdownHeading.Render(line)
+		case strings.HasPrefix(trimmed, "#") && headingRe.MatchString(trimmed):
+			lines[i] = s.MarkdownSubheading.Render(line)
+		case strings.HasPrefix(trimmed, ">"):
+			lines[i] = s.MarkdownQuote.Render(line)
+		case trimmed == "---" || trimmed == "***":
+			lines[i] = s.MarkdownRule.Render(line)
+		case checkboxRe.MatchString(line):
+			if match := checkboxRe.FindStringSubmatch(line); strings.EqualFold(match[1], "x") {
+				lines[i] = s.MarkdownDoneTask.Render(line)
+			} else {
+				lines[i] = s.MarkdownTask.Render(line)
+			}
+		default:
+			line = markdownInlineCodeRe.ReplaceAllStringFunc(line, func(code string) string {
+				return s.MarkdownCode.Render(code)
+			})
+			lines[i] = markdownTagRe.ReplaceAllStringFunc(line, func(match string) string {
+				lead := match[:len(match)-len(strings.TrimLeft(match, " \t"))]
+				return lead + s.MarkdownTag.Render(strings.TrimLeft(match, " \t"))
+			})
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// TestTUIModelTheme checks that a model built in a theme draws its lists,
+// editor and title in that theme's colors, and that withTheme redraws them
+func TestTUIModelTheme(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("NO_COLOR", "")
+
+	nord, dracula := themes["nord"], themes["dracula"]
+
+	m := newTUIModel(nord)
+	checkThemeColors(t, m, nord)
+
+	m = m.withTheme(dracula)
+	checkThemeColors(t, m, dracula)
+	if view := renderMenu(m); strings.Contains(view, foregroundSeq(nord.Primary)) {
+		t.Errorf("menu still drawn in nord after withTheme(dracula):\n%q", view)
+	}
+}
+
+// checkThemeColors fails unless the model's styles use a theme's colors
+func checkThemeColors(t *testing.T, m model, theme Theme) {
+	t.Helper()
+
+	if got := m.styles.Title.GetBackground(); got != lipgloss.Color(theme.Primary) {
+		t.Errorf("%s: title background = %v, want %s", theme.Name, got, theme.Primary)
+	}
+	if got := m.editor.FocusedStyle.Text.GetForeground(); got != lipgloss.Color(theme.Text) {
+		t.Errorf("%s: editor text = %v, want %s", theme.Name, got, theme.Text)
+	}
+	if got := m.editor.FocusedStyle.CursorLine.GetBackground(); got != lipgloss.Color(theme.BackgroundAlt) {
+		t.Errorf("%s: editor cursor line = %v, want %s", theme.Name, got, theme.BackgroundAlt)
+	}
+
+	// The list delegate has no getter, so look for its colors in the menu
+	view := renderMenu(m)
+	for _, color := range []string{theme.Primary, theme.Accent} {
+		if !strings.Contains(view, foregroundSeq(color)) {
+			t.Errorf("%s: selected menu item not drawn in %s:\n%q", theme.Name, color, view)
+		}
+	}
+}
+
+// renderMenu draws the main menu in true color
+func renderMenu(m model) string {
+	m.styles.Renderer.SetColorProfile(termenv.TrueColor)
+	m.menuList.SetSize(80, 30)
+	return m.menuList.View()
+}
+
+// foregroundSeq is the escape sequence parameters for a foreground color
+func foregroundSeq(hex string) string {
+	return termenv.TrueColor.Color(hex).Sequence(false)
+}
@@ -0,0 +1,559 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// tagOnlyRe matches a word that is nothing but a #tag
+var tagOnlyRe = regexp.MustCompile(`^#[\w-]+(?:/[\w-]+)*$`)
+
+// tagEdit is the change a bulk tag operation makes to one file
+type tagEdit struct {
+	path    string
+	before  string
+	after   string
+	changes int // tags added or removed
+}
+
+// hasCanonicalTag reports whether text already uses tag, aliases included
+func hasCanonicalTag(text, tag string) bool {
+	for _, t := range extractTags(text) {
+		if t == canonicalTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// addTagToText adds a tag to a note or journal entry. A "tags:" list in
+// front matter gets the tag; otherwise it is appended to a trailing line of
+// tags, or on a line of its own. Text that already has the tag is unchanged.
+func addTagToText(text, tag string) (string, bool) {
+	if hasCanonicalTag(text, tag) {
+		return text, false
+	}
+	lines := strings.Split(text, "\n")
+
+	if i, end := frontMatterTagsLine(lines); i >= 0 {
+		key, value, _ := strings.Cut(lines[i], ":")
+		value = strings.TrimSpace(value)
+
+		switch {
+		case value == "":
+			// A block of "- tag" items, possibly empty
+			last := i
+			for last+1 < end && strings.HasPrefix(strings.TrimSpace(lines[last+1]), "-") {
+				last++
+			}
+			if last == i {
+				lines[i] = key + ": [" + tag + "]"
+				break
+			}
+			indent := lines[last][:len(lines[last])-len(strings.TrimLeft(lines[last], " \t"))]
+			lines = append(lines[:last+1], append([]string{indent + "- " + tag}, lines[last+1:]...)...)
+		case strings.HasPrefix(value, "["):
+			inner := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"))
+			if inner == "" {
+				lines[i] = key + ": [" + tag + "]"
+			} else {
+				lines[i] = key + ": [" + inner + ", " + tag + "]"
+			}
+		default:
+			lines[i] = key + ": " + value + ", " + tag
+		}
+		return strings.Join(lines, "\n"), true
+	}
+
+	last := len(lines) - 1
+	for last >= 0 && strings.TrimSpace(lines[last]) == "" {
+		last--
+	}
+
+	var insert []string
+	switch {
+	case last < 0:
+		insert = []string{"#" + tag}
+	case isTagLine(lines[last]):
+		lines[last] += " #" + tag
+		return strings.Join(lines, "\n"), true
+	default:
+		insert = []string{"", "#" + tag}
+	}
+	lines = append(lines[:last+1], append(insert, lines[last+1:]...)...)
+	return strings.Join(lines, "\n"), true
+}
+
+// isTagLine reports whether a line holds nothing but #tags
+func isTagLine(line string) bool {
+	fields := strings.Fields(line)
+	for _, field := range fields {
+		if !tagOnlyRe.MatchString(field) {
+			return false
+		}
+	}
+	return len(fields) > 0
+}
+
+// frontMatterTagsLine finds the "tags:" line in front matter, returning its
+// index and the index of the closing '---', or -1 if there is none
+func frontMatterTagsLine(lines []string) (int, int) {
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return -1, -1
+	}
+
+	tags := -1
+	for i := 1; i < len(lines); i++ {
+		line := lines[i]
+		if strings.TrimSpace(line) == "---" {
+			return tags, i
+		}
+		if key, _, found := strings.Cut(line, ":"); found && !strings.HasPrefix(line, " ") && strings.EqualFold(strings.TrimSpace(key), "tags") {
+			tags = i
+		}
+	}
+	return -1, -1
+}
+
+// removeTagFromText strips a tag, and any alias of it, from a note or
+// journal entry, including its front matter. Lines left empty by the
+// removal are dropped. It returns the new text and how many tags it removed.
+func removeTagFromText(text, tag string) (string, int) {
+	tag = canonicalTag(tag)
+	fmSpans, bodyStart := frontMatterTagSpans(text)
+	removed := 0
+
+	// Body tags first; removing them never changes the line count
+	var emptied []int
+	spans := findTagSpans(text)
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start > spans[j].start })
+	for _, span := range spans {
+		if span.start < bodyStart || canonicalTag(text[span.start:span.end]) != tag {
+			continue
+		}
+
+		start, end := span.start-1, span.end
+		if start > 0 && text[start-1] == ' ' {
+			start--
+		} else if end < len(text) && text[end] == ' ' {
+			end++
+		}
+		emptied = append(emptied, strings.Count(text[:start], "\n"))
+		text = text[:start] + text[end:]
+		removed++
+	}
+
+	lines := strings.Split(text, "\n")
+	drop := make(map[int]bool)
+	for _, i := range emptied {
+		if strings.TrimSpace(lines[i]) == "" {
+			drop[i] = true
+		}
+	}
+
+	// Then front matter, where whole "- tag" lines may go
+	if len(fmSpans) > 0 {
+		if i, end := frontMatterTagsLine(lines); i >= 0 {
+			key, value, _ := strings.Cut(lines[i], ":")
+			if strings.TrimSpace(value) == "" {
+				for j := i + 1; j < end && strings.HasPrefix(strings.TrimSpace(lines[j]), "-"); j++ {
+					item := strings.Trim(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(lines[j]), "-")), `"'`)
+					if canonicalTag(item) == tag {
+						drop[j] = true
+						removed++
+					}
+				}
+			} else {
+				var kept []string
+				for _, match := range frontMatterTagRe.FindAllStringSubmatch(value, -1) {
+					if canonicalTag(match[1]) == tag {
+						removed++
+						continue
+					}
+					kept = append(kept, match[1])
+				}
+				if strings.HasPrefix(strings.TrimSpace(value), "[") {
+					lines[i] = key + ": [" + strings.Join(kept, ", ") + "]"
+				} else {
+					lines[i] = key + ": " + strings.Join(kept, ", ")
+				}
+			}
+		}
+	}
+
+	if removed == 0 {
+		return text, 0
+	}
+
+	var result []string
+	for i, line := range lines {
+		if !drop[i] {
+			result = append(result, line)
+		}
+	}
+	return strings.Join(result, "\n"), removed
+}
+
+// editTags applies an add or remove to one file. Journal days are edited
+// entry by entry, so only entries that match are changed; notes and days
+// without timestamped entries are edited as a whole.
+func editTags(journals journalConfigs, path, content, tag string, remove bool, matches func(text string) bool) tagEdit {
+	edit := tagEdit{path: path, before: content, after: content}
+
+	apply := func(text string) (string, int) {
+		if remove {
+			return removeTagFromText(text, tag)
+		}
+		updated, added := addTagToText(text, tag)
+		if added {
+			return updated, 1
+		}
+		return text, 0
+	}
+
+	var entries []journalEntry
+	if _, ok := journals.journalFor(path); ok {
+		entries = parseJournalEntries("", content)
+	}
+	if len(entries) == 0 {
+		if matches(content) {
+			edit.after, edit.changes = apply(content)
+		}
+		return edit
+	}
+
+	// Work backwards so earlier entries keep their line numbers
+	lines := strings.Split(content, "\n")
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if !matches(entry.body) {
+			continue
+		}
+		body := strings.Join(lines[entry.start+1:entry.end], "\n")
+		updated, changes := apply(body)
+		if changes == 0 {
+			continue
+		}
+		rest := append(strings.Split(updated, "\n"), lines[entry.end:]...)
+		lines = append(lines[:entry.start+1], rest...)
+		edit.changes += changes
+	}
+	edit.after = strings.Join(lines, "\n")
+	return edit
+}
+
+// planTagEdits works out the changes adding or removing a tag would make
+// to each file, skipping files it leaves untouched
+func planTagEdits(paths []string, tag string, remove bool, matches func(text string) bool) ([]tagEdit, error) {
+	journals := loadJournalConfigs()
+
+	var edits []tagEdit
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if edit := editTags(journals, path, string(content), tag, remove, matches); edit.changes > 0 {
+			edits = append(edits, edit)
+		}
+	}
+	return edits, nil
+}
+
+// planTagRemovals works out the changes stripping several tags, one after
+// another, would make to each file
+func planTagRemovals(paths, tags []string) ([]tagEdit, error) {
+	all := func(string) bool { return true }
+	journals := loadJournalConfigs()
+
+	var edits []tagEdit
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		edit := tagEdit{path: path, before: string(content), after: string(content)}
+		for _, tag := range tags {
+			step := editTags(journals, path, edit.after, tag, true, all)
+			edit.after = step.after
+			edit.changes += step.changes
+		}
+		if edit.changes > 0 {
+			edits = append(edits, edit)
+		}
+	}
+	return edits, nil
+}
+
+// applyTagEdits writes planned tag edits to disk. A file that changed since
+// the edits were planned is left alone and stops the rest.
+func applyTagEdits(edits []tagEdit) error {
+	for _, edit := range edits {
+		info, err := os.Stat(edit.path)
+		if err != nil {
+			return err
+		}
+		if current, err := os.ReadFile(edit.path); err != nil || string(current) != edit.before {
+			return fmt.Errorf("'%s' changed since the changes were planned; try again", edit.path)
+		}
+		if err := os.WriteFile(edit.path, []byte(edit.after), info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tagEditFilter matches notes and entries containing query and using
+// tagged (or a tag nested below it); empty filters match everything
+func tagEditFilter(query, tagged string) func(text string) bool {
+	query = strings.ToLower(query)
+	if tagged != "" {
+		tagged = canonicalTag(tagged)
+	}
+
+	return func(text string) bool {
+		if query != "" && !strings.Contains(strings.ToLower(text), query) {
+			return false
+		}
+		if tagged == "" {
+			return true
+		}
+		for _, t := range extractTags(text) {
+			if tagMatches(t, tagged) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// diffLines returns the lines removed from before ("- ") and added in after
+// ("+ "), each with its line number. Tag edits change a few lines of a file,
+// so the lines both share at the start and end are skipped and the rest is
+// diffed with Myers' algorithm, which only keeps as much state as there are
+// changes.
+func diffLines(before, after string) []string {
+	a, b := strings.Split(before, "\n"), strings.Split(after, "\n")
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	a, b = a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	// v[k] is the furthest line of a reached on diagonal k = x - y; trace
+	// keeps v[-d..d] before each step d for walking back through the edits
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+		done := false
+		for k := -d; k <= d && !done; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1] // a line added
+			} else {
+				x = v[offset+k-1] + 1 // a line removed
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			done = x >= n && y >= m
+		}
+		if done {
+			break
+		}
+	}
+
+	var diff []string
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d]
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && prev[k-1+d] < prev[k+1+d]) {
+			prevK = k + 1
+		}
+		prevX := prev[prevK+d]
+		prevY := prevX - prevK
+
+		if prevK == k-1 {
+			diff = append(diff, fmt.Sprintf("%4d - %s", prefix+prevX+1, a[prevX]))
+		} else {
+			diff = append(diff, fmt.Sprintf("%4d + %s", prefix+prevY+1, b[prevY]))
+		}
+		x, y = prevX, prevY
+	}
+	slices.Reverse(diff)
+	return diff
+}
+
+// formatTagEdits lists the files a bulk tag operation changes, with a diff
+// of every file when showDiff is set
+func formatTagEdits(edits []tagEdit, showDiff bool) []string {
+	var lines []string
+	for _, edit := range edits {
+		lines = append(lines, fmt.Sprintf("📄 %s (%d)", edit.path, edit.changes))
+		if showDiff {
+			lines = append(lines, diffLines(edit.before, edit.after)...)
+			lines = append(lines, "")
+		}
+	}
+	return lines
+}
+
+// printTagEdits shows what a bulk tag operation changes, with a diff of
+// every file when previewing
+func printTagEdits(edits []tagEdit, showDiff bool) {
+	for _, line := range formatTagEdits(edits, showDiff) {
+		fmt.Println(line)
+	}
+}
+
+// bulkEditTag adds or removes a tag across every matching note and journal
+// entry, or only previews the changes with dryRun
+func bulkEditTag(tag string, remove bool, matches func(text string) bool, dryRun bool) error {
+	tag = normalizeTag(tag)
+	if !tagNameRe.MatchString(tag) {
+		return fmt.Errorf("tags may only contain letters, digits, '-', '_' and '/'")
+	}
+
+	edits, err := planTagEdits(taggedFiles(), tag, remove, matches)
+	if err != nil {
+		return err
+	}
+
+	verb, past := "add", "Added"
+	if remove {
+		verb, past = "remove", "Removed"
+	}
+
+	if len(edits) == 0 {
+		fmt.Printf("📝 Nothing to %s: no matching entries need #%s changed\n", verb, tag)
+		return nil
+	}
+
+	total := 0
+	for _, edit := range edits {
+		total += edit.changes
+	}
+
+	fmt.Println()
+	printTagEdits(edits, dryRun)
+	if dryRun {
+		fmt.Printf("🔍 Would %s #%s %d time(s) in %d file(s)\n", verb, tag, total, len(edits))
+		return nil
+	}
+
+	if err := applyTagEdits(edits); err != nil {
+		return err
+	}
+	fmt.Printf("\n✅ %s #%s %d time(s) in %d file(s)\n", past, tag, total, len(edits))
+	return nil
+}
+
+// tagPreview is a bulk tag change in the TUI waiting to be confirmed
+type tagPreview struct {
+	title string // what the change does, such as "Add #work"
+	edits []tagEdit
+	from  viewMode // view to return to
+	done  string   // status message once the edits are written
+	pane  viewport.Model
+}
+
+// Show the diff of planned tag edits and wait for Enter before writing them
+func (m model) previewTagEdits(title string, edits []tagEdit, done string) (tea.Model, tea.Cmd) {
+	if len(edits) == 0 {
+		m.statusMsg = "Nothing to change"
+		return m, nil
+	}
+
+	total := 0
+	for _, edit := range edits {
+		total += edit.changes
+	}
+
+	var lines []string
+	for _, line := range formatTagEdits(edits, true) {
+		switch {
+		case strings.HasPrefix(line, "📄"):
+			lines = append(lines, m.styles.Header.MarginBottom(0).Render(line))
+		case len(line) > 5 && line[5] == '+':
+			lines = append(lines, m.styles.SuccessText.Render(line))
+		case len(line) > 5 && line[5] == '-':
+			lines = append(lines, m.styles.ErrorText.Render(line))
+		default:
+			lines = append(lines, line)
+		}
+	}
+
+	m.tagPreview = tagPreview{
+		title: fmt.Sprintf("%s: %d change(s) in %d file(s)", title, total, len(edits)),
+		edits: edits,
+		from:  m.mode,
+		done:  done,
+		pane:  viewport.New(m.width-6, m.height-14),
+	}
+	m.tagPreview.pane.SetContent(strings.Join(lines, "\n"))
+	m.mode = tagPreviewView
+	m.statusMsg = "Enter or y to apply, Esc to cancel"
+	return m, nil
+}
+
+// Write the previewed tag edits and go back to where they were started
+func (m model) confirmTagEdits() (tea.Model, tea.Cmd) {
+	preview := m.tagPreview
+	m.tagPreview = tagPreview{}
+	m.mode = preview.from
+
+	if err := applyTagEdits(preview.edits); err != nil {
+		m.statusMsg = "Error: " + err.Error()
+		return m, nil
+	}
+
+	if preview.from == tagsView {
+		next, _ := m.loadTags()
+		m = next.(model)
+	} else {
+		// Clear the marks now that the action is done
+		l := m.currentList()
+		for i, item := range l.Items() {
+			if note, ok := item.(noteItem); ok && note.marked {
+				note.marked = false
+				l.SetItem(i, note)
+			}
+		}
+	}
+	m.statusMsg = preview.done
+	return m, nil
+}
+
+func (m model) renderTagPreview() string {
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.styles.Header.Render("🏷️  "+m.tagPreview.title),
+		m.styles.Panel.Width(m.width-4).Render(m.tagPreview.pane.View()),
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.styles.ActiveButton.Render("↵ Apply (Enter/y)"),
+			m.styles.InactiveButton.Render("Cancel (Esc)"),
+		),
+	)
+}
@@ -1,27 +1,125 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
-// extractTags finds all #tags in content
-func extractTags(content string) []string {
-	// Match #tag but not ##heading
-	re := regexp.MustCompile(`(?:^|[^#\w])#([\w-]+)`)
-	matches := re.FindAllStringSubmatch(content, -1)
+// tagRe matches #tag and nested #tag/subtag, but not ##heading
+var tagRe = regexp.MustCompile(`(?:^|[^#\w])#([\w-]+(?:/[\w-]+)*)`)
 
-	tagMap := make(map[string]bool)
-	for _, match := range matches {
-		if len(match) > 1 {
-			tagMap[strings.ToLower(match[1])] = true
+// tagNameRe matches a tag name without its leading #
+var tagNameRe = regexp.MustCompile(`^[\w-]+(?:/[\w-]+)*$`)
+
+// tagConfig holds tag aliases, mapping an alias to the tag it stands for
+type tagConfig struct {
+	Aliases map[string]string `json:"aliases"`
+}
+
+// tagAliases caches the configured aliases for the duration of a command
+var tagAliases map[string]string
+
+// getTagConfigPath returns the path to the tag config
+func getTagConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".notetype-tags.json"
+	}
+	return filepath.Join(home, ".notetype", "tags.json")
+}
+
+// loadTagConfig loads the tag config, returning an empty one if missing
+func loadTagConfig() tagConfig {
+	cfg := tagConfig{Aliases: make(map[string]string)}
+
+	data, err := os.ReadFile(getTagConfigPath())
+	if err != nil {
+		return cfg
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil || cfg.Aliases == nil {
+		cfg.Aliases = make(map[string]string)
+	}
+	return cfg
+}
+
+// saveTagConfig saves the tag config
+func saveTagConfig(cfg tagConfig) error {
+	configPath := getTagConfigPath()
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Aliases change the tags read from every note
+	tagAliases = nil
+	clear(noteDetailsCache)
+	return os.WriteFile(configPath, data, 0644)
+}
+
+// normalizeTag lowercases a tag and strips the leading # and stray slashes
+func normalizeTag(tag string) string {
+	return strings.Trim(strings.ToLower(strings.TrimPrefix(tag, "#")), "/")
+}
+
+// canonicalTag resolves aliases, so #mtg counts as #meeting and
+// #mtg/weekly as #meeting/weekly
+func canonicalTag(tag string) string {
+	tag = normalizeTag(tag)
+	if tagAliases == nil {
+		tagAliases = loadTagConfig().Aliases
+	}
+
+	// The longest aliased prefix wins
+	prefix := tag
+	for {
+		if target, ok := tagAliases[prefix]; ok {
+			return target + tag[len(prefix):]
 		}
+		i := strings.LastIndex(prefix, "/")
+		if i < 0 {
+			return tag
+		}
+		prefix = prefix[:i]
+	}
+}
+
+// tagMatches reports whether tag is query itself or nested below it
+func tagMatches(tag, query string) bool {
+	return tag == query || strings.HasPrefix(tag, query+"/")
+}
+
+// tagAncestors returns a tag and every parent above it, so work/projectx/api
+// yields work/projectx/api, work/projectx and work
+func tagAncestors(tag string) []string {
+	ancestors := []string{tag}
+	for {
+		i := strings.LastIndex(tag, "/")
+		if i < 0 {
+			return ancestors
+		}
+		tag = tag[:i]
+		ancestors = append(ancestors, tag)
+	}
+}
+
+// extractTags finds all #tags in content, with aliases resolved. Tags in
+// code, links and HTML are skipped and front matter tags are included.
+func extractTags(content string) []string {
+	tagMap := make(map[string]bool)
+	for _, span := range findTagSpans(content) {
+		tagMap[canonicalTag(content[span.start:span.end])] = true
 	}
 
 	var tags []string
@@ -32,35 +130,93 @@ func extractTags(content string) []string {
 	return tags
 }
 
-// getAllTags scans all files and returns tag usage count
-func getAllTags() (map[string]int, error) {
-	tagCounts := make(map[string]int)
+// tagJournalScope limits tag scans to a single journal; empty means all journals
+var tagJournalScope string
 
-	// Scan journal entries
-	journalDir := getJournalDir()
-	if _, err := os.Stat(journalDir); err == nil {
-		journalFiles, _ := filepath.Glob(filepath.Join(journalDir, "*.md"))
-		for _, file := range journalFiles {
-			content, err := os.ReadFile(file)
-			if err != nil {
-				continue
-			}
-			tags := extractTags(string(content))
-			for _, tag := range tags {
-				tagCounts[tag]++
-			}
+// tagNotebookScope limits tag scans to the notes of one notebook; journals
+// are left out unless --journal is given too
+var tagNotebookScope string
+
+// taggedFiles returns every journal entry and note scanned for tags
+func taggedFiles() []string {
+	return filesInScope(tagJournalScope, tagNotebookScope)
+}
+
+// filesInScope returns the journal days and notes in a journal and
+// notebook. With neither, it returns everything; with only a notebook,
+// only that notebook's notes, and with only a journal, only its days.
+func filesInScope(journal, notebook string) []string {
+	var files []string
+
+	if notebook != "" && journal == "" {
+		return noteFiles(notebook)
+	}
+
+	for _, journalDir := range journalDirsInScope(journal) {
+		if _, err := os.Stat(journalDir); err != nil {
+			continue
 		}
+		journalFiles, _ := filepath.Glob(filepath.Join(journalDir, "*.md"))
+		files = append(files, journalFiles...)
+	}
+	if journal != "" && notebook == "" {
+		return files
 	}
 
-	// Scan regular notes
-	noteFiles, _ := filepath.Glob("*.md")
-	for _, file := range noteFiles {
+	return append(files, noteFiles(notebook)...)
+}
+
+// taggedFile is a scanned journal day or note with the tags it uses
+type taggedFile struct {
+	path string
+	date time.Time // the journal day, or when a note was last modified
+	tags []string
+}
+
+// scanTaggedFiles reads every journal entry and note and extracts its tags
+func scanTaggedFiles() []taggedFile {
+	var scanned []taggedFile
+	journals := loadJournalConfigs()
+
+	for _, file := range taggedFiles() {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
 		content, err := os.ReadFile(file)
 		if err != nil {
 			continue
 		}
-		tags := extractTags(string(content))
-		for _, tag := range tags {
+
+		date := info.ModTime()
+		if _, ok := journals.journalFor(file); ok {
+			if day, err := time.ParseInLocation("2006-01-02", strings.TrimSuffix(filepath.Base(file), ".md"), time.Local); err == nil {
+				date = day
+			}
+		}
+
+		scanned = append(scanned, taggedFile{path: file, date: date, tags: extractTags(string(content))})
+	}
+
+	return scanned
+}
+
+// hasTag reports whether a file uses a tag or a tag nested below it
+func (f taggedFile) hasTag(tag string) bool {
+	for _, t := range f.tags {
+		if tagMatches(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAllTags scans all files and returns tag usage count
+func getAllTags() (map[string]int, error) {
+	tagCounts := make(map[string]int)
+
+	for _, file := range scanTaggedFiles() {
+		for _, tag := range file.tags {
 			tagCounts[tag]++
 		}
 	}
@@ -68,47 +224,295 @@ func getAllTags() (map[string]int, error) {
 	return tagCounts, nil
 }
 
-// findFilesByTag returns files containing the specified tag
+// getTagTreeCounts scans all files and counts, for every tag and every
+// parent tag, how many files use it or any tag nested below it
+func getTagTreeCounts() (map[string]int, error) {
+	return tagTreeCounts(scanTaggedFiles()), nil
+}
+
+// tagTreeCounts counts tags and their parents over already scanned files
+func tagTreeCounts(files []taggedFile) map[string]int {
+	tagCounts := make(map[string]int)
+
+	for _, file := range files {
+		seen := make(map[string]bool)
+		for _, tag := range file.tags {
+			for _, ancestor := range tagAncestors(tag) {
+				seen[ancestor] = true
+			}
+		}
+		for tag := range seen {
+			tagCounts[tag]++
+		}
+	}
+
+	return tagCounts
+}
+
+// tagTreeOrder sorts tags so that every tag is followed by its children
+func tagTreeOrder(tags []string) {
+	sort.Slice(tags, func(i, j int) bool {
+		a, b := strings.Split(tags[i], "/"), strings.Split(tags[j], "/")
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+}
+
+// findFilesByTag returns files containing the specified tag or a tag
+// nested below it
 func findFilesByTag(tag string) ([]string, error) {
-	tag = strings.ToLower(tag)
+	tag = canonicalTag(tag)
 	var matchingFiles []string
 
-	// Search journal entries
-	journalDir := getJournalDir()
-	if _, err := os.Stat(journalDir); err == nil {
-		journalFiles, _ := filepath.Glob(filepath.Join(journalDir, "*.md"))
-		for _, file := range journalFiles {
-			content, err := os.ReadFile(file)
-			if err != nil {
+	for _, file := range scanTaggedFiles() {
+		if file.hasTag(tag) {
+			matchingFiles = append(matchingFiles, file.path)
+		}
+	}
+
+	return matchingFiles, nil
+}
+
+// relatedTag is a tag that appears in the same files as another tag
+type relatedTag struct {
+	tag    string
+	count  int // files using both tags
+	recent int // of those, files dated within the period
+}
+
+// tagPeriods are the periods related tags can be counted over
+var tagPeriods = []string{"week", "month", "year", "all"}
+
+// nextTagPeriod returns the period after period; an empty one means "month"
+func nextTagPeriod(period string) string {
+	for i, p := range tagPeriods {
+		if p == period {
+			return tagPeriods[(i+1)%len(tagPeriods)]
+		}
+	}
+	return tagPeriods[2]
+}
+
+// tagPeriodStart returns when a period such as "month" began, and how to
+// describe it. "all" has no start.
+func tagPeriodStart(period string, now time.Time) (time.Time, string, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch strings.ToLower(period) {
+	case "week":
+		offset := (int(today.Weekday()) + 6) % 7 // weeks start on Monday
+		return today.AddDate(0, 0, -offset), "this week", nil
+	case "month":
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()), "this month", nil
+	case "year":
+		return time.Date(now.Year(), 1, 1, 0, 0, 0, 0, now.Location()), "this year", nil
+	case "all":
+		return time.Time{}, "", nil
+	}
+	return time.Time{}, "", fmt.Errorf("unknown period '%s' (use week, month, year or all)", period)
+}
+
+// relatedTags counts the tags that appear in the same files as tag or a tag
+// nested below it, most frequent first, listing those nested tags too. It
+// also returns how many files use tag.
+func relatedTags(files []taggedFile, tag string, since time.Time) ([]relatedTag, int) {
+	counts := make(map[string]*relatedTag)
+	matches := 0
+
+	for _, file := range files {
+		if !file.hasTag(tag) {
+			continue
+		}
+		matches++
+
+		for _, t := range file.tags {
+			if t == tag {
 				continue
 			}
-			tags := extractTags(string(content))
-			for _, t := range tags {
-				if t == tag {
-					matchingFiles = append(matchingFiles, file)
-					break
-				}
+			related, ok := counts[t]
+			if !ok {
+				related = &relatedTag{tag: t}
+				counts[t] = related
+			}
+			related.count++
+			if !since.IsZero() && !file.date.Before(since) {
+				related.recent++
 			}
 		}
 	}
 
-	// Search regular notes
-	noteFiles, _ := filepath.Glob("*.md")
-	for _, file := range noteFiles {
+	var related []relatedTag
+	for _, r := range counts {
+		related = append(related, *r)
+	}
+	sort.Slice(related, func(i, j int) bool {
+		if related[i].count != related[j].count {
+			return related[i].count > related[j].count
+		}
+		if related[i].recent != related[j].recent {
+			return related[i].recent > related[j].recent
+		}
+		return related[i].tag < related[j].tag
+	})
+
+	return related, matches
+}
+
+// formatRelatedTag describes how often a tag appeared alongside another
+func formatRelatedTag(r relatedTag, periodLabel string) string {
+	text := fmt.Sprintf("%d together", r.count)
+	if periodLabel != "" {
+		text += fmt.Sprintf(" · %d %s", r.recent, periodLabel)
+	}
+	return text
+}
+
+// renameTagInContent rewrites #old, and tags nested below it, to #new,
+// keeping the case of the nested part as written. It returns the new
+// content and how many tags were rewritten.
+func renameTagInContent(content, oldTag, newTag string) (string, int) {
+	var b strings.Builder
+	last, count := 0, 0
+
+	spans := findTagSpans(content)
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	for _, span := range spans {
+		start, end := span.start, span.end
+		tag := strings.ToLower(content[start:end])
+		if !tagMatches(tag, oldTag) {
+			continue
+		}
+		suffix := tag[len(oldTag):]
+		if len(tag) == end-start {
+			suffix = content[start+len(oldTag) : end]
+		}
+		b.WriteString(content[last:start])
+		b.WriteString(newTag + suffix)
+		last = end
+		count++
+	}
+
+	if count == 0 {
+		return content, 0
+	}
+	b.WriteString(content[last:])
+	return b.String(), count
+}
+
+// renameTag rewrites a tag across all notes and journals and points any
+// aliases for it at the new name
+func renameTag(oldTag, newTag string, dryRun bool) error {
+	oldTag, newTag = normalizeTag(oldTag), normalizeTag(newTag)
+	if !tagNameRe.MatchString(oldTag) || !tagNameRe.MatchString(newTag) {
+		return fmt.Errorf("tags may only contain letters, digits, '-', '_' and '/'")
+	}
+	if oldTag == newTag {
+		return fmt.Errorf("#%s and #%s are the same tag", oldTag, newTag)
+	}
+	if tagMatches(newTag, oldTag) {
+		return fmt.Errorf("cannot move #%s below itself", oldTag)
+	}
+
+	files, total := 0, 0
+	for _, file := range taggedFiles() {
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
 		content, err := os.ReadFile(file)
 		if err != nil {
+			return err
+		}
+
+		updated, count := renameTagInContent(string(content), oldTag, newTag)
+		if count == 0 {
 			continue
 		}
-		tags := extractTags(string(content))
-		for _, t := range tags {
-			if t == tag {
-				matchingFiles = append(matchingFiles, file)
-				break
+		if !dryRun {
+			if err := os.WriteFile(file, []byte(updated), info.Mode().Perm()); err != nil {
+				return err
 			}
 		}
+
+		files++
+		total += count
+		fmt.Printf("  • %s (%d)\n", file, count)
 	}
 
-	return matchingFiles, nil
+	if dryRun {
+		fmt.Printf("\n🔍 Would rename %d tag(s) in %d file(s) from #%s to #%s\n", total, files, oldTag, newTag)
+		return nil
+	}
+
+	// Aliases that pointed at the old tag now point at the new one
+	cfg := loadTagConfig()
+	changed := false
+	for alias, target := range cfg.Aliases {
+		if tagMatches(target, oldTag) {
+			cfg.Aliases[alias] = newTag + target[len(oldTag):]
+			changed = true
+		}
+	}
+	if changed {
+		if err := saveTagConfig(cfg); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\n✅ Renamed %d tag(s) in %d file(s) from #%s to #%s\n", total, files, oldTag, newTag)
+	return nil
+}
+
+// setTagAlias makes alias stand for tag when notes are indexed. Aliases that
+// pointed at the alias are merged into tag as well.
+func setTagAlias(alias, tag string) error {
+	alias = normalizeTag(alias)
+	if !tagNameRe.MatchString(alias) || !tagNameRe.MatchString(normalizeTag(tag)) {
+		return fmt.Errorf("tags may only contain letters, digits, '-', '_' and '/'")
+	}
+
+	cfg := loadTagConfig()
+	delete(cfg.Aliases, alias)
+	tagAliases = cfg.Aliases
+	tag = canonicalTag(tag)
+	if tagMatches(tag, alias) {
+		return fmt.Errorf("#%s cannot be an alias for itself", alias)
+	}
+
+	for other, target := range cfg.Aliases {
+		if tagMatches(target, alias) {
+			cfg.Aliases[other] = tag + target[len(alias):]
+		}
+	}
+	cfg.Aliases[alias] = tag
+	return saveTagConfig(cfg)
+}
+
+// listTagAliases prints every configured alias
+func listTagAliases() {
+	aliases := loadTagConfig().Aliases
+	if len(aliases) == 0 {
+		fmt.Println("📝 No tag aliases. Add one with 'notetype tags alias <alias> <tag>'")
+		return
+	}
+
+	var names []string
+	for alias := range aliases {
+		names = append(names, alias)
+	}
+	sort.Strings(names)
+
+	fmt.Print("\n🔗 Tag Aliases:\n\n")
+	for _, alias := range names {
+		fmt.Printf("  #%-20s → #%s\n", alias, aliases[alias])
+	}
+	fmt.Println()
+	fmt.Printf("📍 Config: %s\n", getTagConfigPath())
 }
 
 // tagsCmd represents the tags command
@@ -120,11 +524,43 @@ var tagsCmd = &cobra.Command{
 Tags are created by using #hashtag syntax in your notes.
 For example: "Today I worked on #project #coding"
 
+Anything inside code blocks, inline code, link URLs and HTML tags is
+ignored, as are bare numbers like #123. A "tags:" list in a note's front
+matter is counted as well.
+
+Tags can be nested with a slash, like #work/projectx. Showing a tag
+includes every tag nested below it, so #work finds #work/projectx too.
+
+Aliases merge tags while notes are indexed: with #mtg aliased to
+#meeting, notes tagged #mtg count as #meeting. Aliases are stored in
+~/.notetype/tags.json.
+
 Examples:
   notetype tags              # List all tags
   notetype tags list         # List all tags with counts
-  notetype tags show work    # Show all entries with #work tag
+  notetype tags list --tree  # Show nested tags as a tree
+  notetype tags show work    # Show all entries with #work or #work/...
+  notetype tags related stress       # Tags that appear alongside #stress
+  notetype tags add urgent --query deadline   # Tag every entry mentioning "deadline"
+  notetype tags remove draft --tagged done    # Untag entries that are #done
+  notetype tags alias mtg meeting    # Count #mtg as #meeting
+  notetype tags rename mtg meeting   # Rewrite #mtg to #meeting everywhere
+  notetype tags --journal work   # Only count tags in the 'work' journal
+  notetype tags --notebook work  # Only count tags in the 'work' notebook
 `,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if err := checkNotebook(tagNotebookScope); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		if tagJournalScope == "" {
+			return
+		}
+		if _, exists := getJournalConfig(tagJournalScope); !exists {
+			fmt.Printf("❌ Journal '%s' not found\n", tagJournalScope)
+			os.Exit(1)
+		}
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		listAllTags()
 	},
@@ -135,6 +571,11 @@ var tagsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all tags with usage counts",
 	Run: func(cmd *cobra.Command, args []string) {
+		tree, _ := cmd.Flags().GetBool("tree")
+		if tree {
+			listTagTree()
+			return
+		}
 		listAllTags()
 	},
 }
@@ -145,9 +586,8 @@ var tagsShowCmd = &cobra.Command{
 	Short: "Show all entries with a specific tag",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		tag := args[0]
-		// Remove # if provided
-		tag = strings.TrimPrefix(tag, "#")
+		// Remove # if provided and resolve aliases
+		tag := canonicalTag(args[0])
 
 		files, err := findFilesByTag(tag)
 		if err != nil {
@@ -160,16 +600,250 @@ var tagsShowCmd = &cobra.Command{
 			return
 		}
 
+		journals := loadJournalConfigs()
 		fmt.Printf("\n📌 Found %d entry/entries with #%s:\n\n", len(files), tag)
 		for _, file := range files {
-			base := filepath.Base(file)
-			name := strings.TrimSuffix(base, ".md")
+			name := noteLinkName(journals, file)
+
+			// Journal tags belong to the timestamped block they were written in
+			if journal, ok := journals.journalFor(file); ok {
+				name = strings.TrimSuffix(filepath.Base(file), ".md")
+				if journal != defaultJournalName {
+					name = journal + "/" + name
+				}
+				if content, err := os.ReadFile(file); err == nil {
+					printed := false
+					for _, entry := range parseJournalEntries(name, string(content)) {
+						if entryMatches(entry, tag, "") {
+							fmt.Printf("  • %s 🕒 %s  %s\n", name, entry.time, entryPreview(entry, 50))
+							printed = true
+						}
+					}
+					if printed {
+						continue
+					}
+				}
+			}
+
 			fmt.Printf("  • %s\n", name)
 		}
 		fmt.Println()
 	},
 }
 
+// tagsRelatedCmd shows the tags that most often appear with a tag
+var tagsRelatedCmd = &cobra.Command{
+	Use:   "related <tag>",
+	Short: "Show which tags appear together with a tag",
+	Long: `Show the tags that most often appear in the same notes and journal
+days as a tag, with how many of those fall in the current period.
+
+Examples:
+  notetype tags related stress
+  notetype tags related work --period week
+  notetype tags related work/projectx --period all --limit 20`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		period, _ := cmd.Flags().GetString("period")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		since, label, err := tagPeriodStart(period, time.Now())
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		tag := canonicalTag(args[0])
+		related, matches := relatedTags(scanTaggedFiles(), tag, since)
+		if matches == 0 {
+			fmt.Printf("📝 No entries found with tag #%s\n", tag)
+			return
+		}
+		if len(related) == 0 {
+			fmt.Printf("📝 #%s never appears with another tag (%d entries)\n", tag, matches)
+			return
+		}
+
+		fmt.Printf("\n🔗 Tags related to #%s (%d entries):\n\n", tag, matches)
+		for i, r := range related {
+			if limit > 0 && i == limit {
+				fmt.Printf("  … and %d more\n", len(related)-limit)
+				break
+			}
+			fmt.Printf("  #%-20s %s\n", r.tag, formatRelatedTag(r, label))
+		}
+		fmt.Println()
+	},
+}
+
+// tagsAddCmd adds a tag to every matching note and journal entry
+var tagsAddCmd = &cobra.Command{
+	Use:   "add <tag>",
+	Short: "Add a tag to every matching note and journal entry",
+	Long: `Add a tag to every note and journal entry that matches the filters.
+
+Journal days are tagged entry by entry, so only the timestamped entries
+that match get the tag. Notes with a "tags:" list in their front matter
+get the tag there; otherwise it is added on the last line.
+
+Use --dry-run to see a diff of every change before making it.
+
+Examples:
+  notetype tags add urgent --query deadline --dry-run
+  notetype tags add work/projectx --query "project x"
+  notetype tags add review --tagged draft --journal work
+  notetype tags add inbox --all`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		query, _ := cmd.Flags().GetString("query")
+		tagged, _ := cmd.Flags().GetString("tagged")
+		all, _ := cmd.Flags().GetBool("all")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if query == "" && tagged == "" && !all {
+			fmt.Println("❌ Pick entries with --query or --tagged, or use --all to tag everything")
+			os.Exit(1)
+		}
+
+		if err := bulkEditTag(args[0], false, tagEditFilter(query, tagged), dryRun); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// tagsRemoveCmd strips a tag from every matching note and journal entry
+var tagsRemoveCmd = &cobra.Command{
+	Use:   "remove <tag>",
+	Short: "Remove a tag from every matching note and journal entry",
+	Long: `Strip a tag from every note and journal entry that matches the filters,
+or from everything when no filter is given. Aliases of the tag and its
+entries in front matter "tags:" lists are removed as well; tags nested
+below it are kept.
+
+Use --dry-run to see a diff of every change before making it.
+
+Examples:
+  notetype tags remove draft --dry-run
+  notetype tags remove todo --query "shipped"
+  notetype tags remove urgent --tagged done`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		query, _ := cmd.Flags().GetString("query")
+		tagged, _ := cmd.Flags().GetString("tagged")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if err := bulkEditTag(args[0], true, tagEditFilter(query, tagged), dryRun); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// tagsRenameCmd rewrites a tag across all notes and journals
+var tagsRenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename a tag in every note and journal",
+	Long: `Rewrite every occurrence of a tag across your notes and journals.
+
+Tags nested below the old tag move along with it, so renaming #work to
+#job turns #work/projectx into #job/projectx. Aliases that pointed at the
+old tag are updated too.
+
+Examples:
+  notetype tags rename mtg meeting
+  notetype tags rename work job --dry-run
+  notetype tags rename todo work/todo --journal work`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if err := renameTag(args[0], args[1], dryRun); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// tagsAliasCmd manages tag aliases
+var tagsAliasCmd = &cobra.Command{
+	Use:   "alias [<alias> <tag>]",
+	Short: "List, add or remove tag aliases",
+	Long: `Make one tag count as another while notes are indexed.
+
+Notes keep their original text; use 'notetype tags rename' to rewrite
+them. Without arguments, lists every alias.
+
+Examples:
+  notetype tags alias                    # List aliases
+  notetype tags alias mtg meeting        # Count #mtg as #meeting
+  notetype tags alias proj work/projectx
+  notetype tags alias mtg --remove       # Stop treating #mtg as #meeting`,
+	Args: cobra.RangeArgs(0, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		remove, _ := cmd.Flags().GetBool("remove")
+
+		switch {
+		case remove:
+			if len(args) != 1 {
+				fmt.Println("❌ Usage: notetype tags alias <alias> --remove")
+				os.Exit(1)
+			}
+			alias := normalizeTag(args[0])
+			cfg := loadTagConfig()
+			if _, ok := cfg.Aliases[alias]; !ok {
+				fmt.Printf("❌ #%s is not an alias\n", alias)
+				os.Exit(1)
+			}
+			delete(cfg.Aliases, alias)
+			if err := saveTagConfig(cfg); err != nil {
+				fmt.Printf("❌ Error saving aliases: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("✅ Removed alias #%s\n", alias)
+		case len(args) == 0:
+			listTagAliases()
+		case len(args) == 2:
+			if err := setTagAlias(args[0], args[1]); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("✅ #%s now counts as #%s\n", normalizeTag(args[0]), canonicalTag(args[0]))
+		default:
+			fmt.Println("❌ Usage: notetype tags alias <alias> <tag>")
+			os.Exit(1)
+		}
+	},
+}
+
+// listTagTree prints tags as a tree, with parents counting nested tags
+func listTagTree() {
+	tagCounts, err := getTagTreeCounts()
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		return
+	}
+
+	if len(tagCounts) == 0 {
+		fmt.Println("📝 No tags found. Add tags to your notes using #hashtag syntax")
+		return
+	}
+
+	var tags []string
+	for tag := range tagCounts {
+		tags = append(tags, tag)
+	}
+	tagTreeOrder(tags)
+
+	fmt.Print("\n🏷️  Tag Tree:\n\n")
+	for _, tag := range tags {
+		depth := strings.Count(tag, "/")
+		name := tag[strings.LastIndex(tag, "/")+1:]
+		fmt.Printf("  %s#%-*s (%d)\n", strings.Repeat("  ", depth), 20-2*depth, name, tagCounts[tag])
+	}
+	fmt.Println()
+	fmt.Println("💡 Use 'notetype tags show <tag>' to see entries with a tag and its subtags")
+}
+
 func listAllTags() {
 	tagCounts, err := getAllTags()
 	if err != nil {
@@ -207,7 +881,24 @@ func listAllTags() {
 }
 
 func init() {
+	tagsCmd.PersistentFlags().StringVarP(&tagJournalScope, "journal", "j", "", "Only scan this journal (default: all journals)")
+	tagsCmd.PersistentFlags().StringVarP(&tagNotebookScope, "notebook", "b", "", "Only scan notes in this notebook")
 	tagsCmd.AddCommand(tagsListCmd)
 	tagsCmd.AddCommand(tagsShowCmd)
+	tagsListCmd.Flags().Bool("tree", false, "Show nested tags as a tree")
+	tagsRelatedCmd.Flags().String("period", "month", "Period to count recent co-occurrences in: week, month, year or all")
+	tagsRelatedCmd.Flags().IntP("limit", "n", 10, "Show at most this many tags (0 for all)")
+	tagsCmd.AddCommand(tagsRelatedCmd)
+	for _, c := range []*cobra.Command{tagsAddCmd, tagsRemoveCmd} {
+		c.Flags().StringP("query", "q", "", "Only change notes and entries containing this text")
+		c.Flags().String("tagged", "", "Only change notes and entries with this tag")
+		c.Flags().Bool("dry-run", false, "Show a diff of the changes without writing")
+		tagsCmd.AddCommand(c)
+	}
+	tagsAddCmd.Flags().Bool("all", false, "Add the tag to every note and journal entry")
+	tagsRenameCmd.Flags().Bool("dry-run", false, "Show what would change without writing")
+	tagsCmd.AddCommand(tagsRenameCmd)
+	tagsAliasCmd.Flags().Bool("remove", false, "Remove the alias")
+	tagsCmd.AddCommand(tagsAliasCmd)
 	rootCmd.AddCommand(tagsCmd)
 }
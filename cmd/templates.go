@@ -1,18 +1,29 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 )
 
+var templateNameRe = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
 // Built-in templates
 var builtInTemplates = map[string]string{
-	"daily": `# Daily Journal - {{date}}
+	"daily": `---
+description: Daily journal with morning/evening sections
+filename: daily-{{date}}
+category: Journal
+---
+# Daily Journal - {{date}}
 
 ## Morning 🌅
 **Mood:** 
@@ -48,10 +59,15 @@ var builtInTemplates = map[string]string{
 #journal #daily
 `,
 
-	"meeting": `# Meeting Notes - {{date}}
+	"meeting": `---
+description: Meeting notes with agenda and action items
+filename: meeting-{{date}}-{{slug title}}
+category: Work
+---
+# Meeting Notes - {{date}}
 
 **Date:** {{datetime}}
-**Attendees:** 
+**Attendees:** {{prompt "Attendees"}}
 
 ## Agenda
 1. 
@@ -77,7 +93,12 @@ var builtInTemplates = map[string]string{
 #meeting #work
 `,
 
-	"project": `# Project: {{title}}
+	"project": `---
+description: Project planning template
+filename: project-{{slug title}}
+category: Work
+---
+# Project: {{title}}
 
 **Start Date:** {{date}}
 **Status:** Planning
@@ -110,7 +131,12 @@ var builtInTemplates = map[string]string{
 #project #planning
 `,
 
-	"weekly": `# Weekly Review - Week of {{date}}
+	"weekly": `---
+description: Weekly review and reflection
+filename: weekly-{{date}}
+category: Journal
+---
+# Weekly Review - Week of {{date}}
 
 ## 📊 Overview
 
@@ -138,7 +164,12 @@ var builtInTemplates = map[string]string{
 #weekly-review #reflection
 `,
 
-	"idea": `# Idea: {{title}}
+	"idea": `---
+description: Capture and develop ideas
+filename: idea-{{slug title}}
+category: Ideas
+---
+# Idea: {{title}}
 
 **Date:** {{date}}
 
@@ -163,7 +194,12 @@ var builtInTemplates = map[string]string{
 #ideas #brainstorm
 `,
 
-	"grateful": `# Gratitude - {{date}}
+	"grateful": `---
+description: Gratitude journal entry
+filename: grateful-{{date}}
+category: Journal
+---
+# Gratitude - {{date}}
 
 Today I'm grateful for:
 
@@ -197,109 +233,369 @@ func ensureTemplateDir() error {
 	return os.MkdirAll(templateDir, 0755)
 }
 
-// substituteVariables replaces template variables with actual values
-func substituteVariables(content string, vars map[string]string) string {
-	result := content
-	for key, value := range vars {
-		placeholder := "{{" + key + "}}"
-		result = strings.ReplaceAll(result, placeholder, value)
+// getCustomTemplatePath returns the file of a custom template
+func getCustomTemplatePath(name string) string {
+	return filepath.Join(getTemplateDir(), name+".md")
+}
+
+// customTemplateExists reports whether a custom template file exists
+func customTemplateExists(name string) bool {
+	_, err := os.Stat(getCustomTemplatePath(name))
+	return err == nil
+}
+
+// customTemplateNames returns the names of all custom templates, sorted
+func customTemplateNames() []string {
+	files, _ := filepath.Glob(filepath.Join(getTemplateDir(), "*.md"))
+
+	var names []string
+	for _, file := range files {
+		names = append(names, strings.TrimSuffix(filepath.Base(file), ".md"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// availableTemplates returns the built-in templates followed by the custom
+// templates that don't override one of them
+func availableTemplates() []string {
+	names := []string{"daily", "meeting", "project", "weekly", "idea", "grateful"}
+	for _, name := range customTemplateNames() {
+		if _, builtIn := builtInTemplates[name]; !builtIn {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// templateMeta is the optional front-matter header of a template:
+//
+//	---
+//	description: Meeting notes with agenda and action items
+//	filename: meeting-{{date}}-{{slug title}}
+//	folder: meetings
+//	tags: meeting, work
+//	category: Work
+//	recur: 0 9 * * 1-5
+//	---
+type templateMeta struct {
+	Description string
+	Filename    string
+	Folder      string
+	Tags        []string
+	Category    string
+	Recur       string
+}
+
+// parseTemplateFrontMatter splits a template into its metadata and its body.
+// Templates without a front-matter header are returned unchanged, and keys
+// other than the template settings, such as a note's title, are ignored.
+func parseTemplateFrontMatter(source string) (templateMeta, string, error) {
+	var meta templateMeta
+
+	lines := strings.Split(source, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return meta, source, nil
+	}
+
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return meta, source, fmt.Errorf("template front matter is missing its closing '---'")
+	}
+
+	lastKey := ""
+	for i, line := range lines[1:end] {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		// Indented lines continue the key above, as in a YAML list of tags
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "- ") {
+			item, isItem := strings.CutPrefix(strings.TrimSpace(line), "- ")
+			if lastKey == "tags" && isItem {
+				if tag := strings.TrimPrefix(strings.Trim(strings.TrimSpace(item), `"'`), "#"); tag != "" {
+					meta.Tags = append(meta.Tags, strings.ToLower(tag))
+				}
+			}
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			return meta, source, fmt.Errorf("invalid front matter on line %d: %q", i+2, line)
+		}
+		value = strings.TrimSpace(value)
+		lastKey = strings.ToLower(strings.TrimSpace(key))
+
+		switch lastKey {
+		case "description":
+			meta.Description = value
+		case "filename":
+			meta.Filename = value
+		case "folder":
+			meta.Folder = value
+		case "category":
+			meta.Category = value
+		case "recur":
+			meta.Recur = strings.Trim(value, `"'`)
+		case "tags":
+			value = strings.Trim(value, "[]")
+			for _, tag := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+				if tag = strings.TrimPrefix(strings.Trim(tag, `"'`), "#"); tag != "" {
+					meta.Tags = append(meta.Tags, strings.ToLower(tag))
+				}
+			}
+		}
+	}
+
+	return meta, strings.Join(lines[end+1:], "\n"), nil
+}
+
+// getTemplateSource returns a template exactly as stored, front matter included.
+// A custom template takes precedence over the built-in template of the same name.
+func getTemplateSource(templateName string) (string, error) {
+	// Check custom templates first so they can override built-ins
+	if content, err := os.ReadFile(getCustomTemplatePath(templateName)); err == nil {
+		return string(content), nil
+	}
+
+	// Fall back to built-in templates
+	if content, exists := builtInTemplates[templateName]; exists {
+		return content, nil
+	}
+
+	return "", fmt.Errorf("template '%s' not found", templateName)
+}
+
+// loadTemplate returns the metadata and body of a template
+func loadTemplate(templateName string) (templateMeta, string, error) {
+	source, err := getTemplateSource(templateName)
+	if err != nil {
+		return templateMeta{}, "", err
+	}
+
+	meta, body, err := parseTemplateFrontMatter(source)
+	if err != nil {
+		return meta, "", fmt.Errorf("template '%s': %v", templateName, err)
 	}
-	return result
+	return meta, body, nil
 }
 
-// applyTemplate creates a note from a template
-func applyTemplate(templateName, filename, title string) error {
-	// Get template content
-	var templateContent string
-	var exists bool
+// getTemplateContent returns the body of a template, without its front matter
+func getTemplateContent(templateName string) (string, error) {
+	_, body, err := loadTemplate(templateName)
+	return body, err
+}
 
-	// Check built-in templates first
-	templateContent, exists = builtInTemplates[templateName]
+// getTemplateMeta returns the metadata of a template
+func getTemplateMeta(templateName string) templateMeta {
+	meta, _, _ := loadTemplate(templateName)
+	return meta
+}
 
-	// Check custom templates
-	if !exists {
-		templatePath := filepath.Join(getTemplateDir(), templateName+".md")
-		content, err := os.ReadFile(templatePath)
+// renderNoteTemplate renders a template and adds the default tags it
+// declares that the rendered note doesn't already contain
+func renderNoteTemplate(templateName string, ctx templateContext) (string, templateMeta, error) {
+	meta, body, err := loadTemplate(templateName)
+	if err != nil {
+		return "", meta, err
+	}
+
+	content, err := renderTemplate(body, ctx)
+	if err != nil {
+		return "", meta, err
+	}
+
+	present := make(map[string]bool)
+	for _, tag := range extractTags(content) {
+		present[tag] = true
+	}
+	var missing []string
+	for _, tag := range meta.Tags {
+		if !present[tag] {
+			missing = append(missing, tag)
+		}
+	}
+	if len(missing) > 0 {
+		content = strings.TrimRight(content, "\n") + "\n\n---\n#" + strings.Join(missing, " #") + "\n"
+	}
+
+	return content, meta, nil
+}
+
+// templateTargetPath works out where a note created from a template goes,
+// without the .md extension. An explicit filename wins over the template's
+// filename pattern; the template's folder applies to both.
+func templateTargetPath(templateName string, meta templateMeta, filename string, ctx templateContext) (string, error) {
+	if filename == "" {
+		pattern := meta.Filename
+		if pattern == "" {
+			pattern = templateName + "-{{date}}{{if title}}-{{slug title}}{{end}}"
+		}
+
+		rendered, err := renderTemplate(pattern, ctx)
 		if err != nil {
-			return fmt.Errorf("template '%s' not found", templateName)
+			return "", fmt.Errorf("error in filename pattern: %v", err)
 		}
-		templateContent = string(content)
+		filename = strings.TrimSuffix(strings.TrimSpace(rendered), ".md")
+		if filename == "" {
+			return "", fmt.Errorf("filename pattern of template '%s' produced an empty name", templateName)
+		}
+	}
+
+	filename, err := cleanNotePath(filename)
+	if err != nil {
+		return "", err
+	}
+	if meta.Folder == "" {
+		return filename, nil
 	}
 
-	// Prepare variables
-	now := time.Now()
-	vars := map[string]string{
-		"date":     now.Format("2006-01-02"),
-		"datetime": now.Format("2006-01-02 15:04"),
-		"time":     now.Format("15:04"),
-		"title":    title,
-		"year":     now.Format("2006"),
-		"month":    now.Format("January"),
-		"day":      now.Format("Monday"),
+	folder, err := cleanNotePath(meta.Folder)
+	if err != nil {
+		return "", fmt.Errorf("folder of template '%s': %v", templateName, err)
 	}
+	return filepath.Join(folder, filename), nil
+}
+
+// cleanNotePath cleans a path relative to the notes folder, refusing empty,
+// absolute and home paths and any that climb out with ".."
+func cleanNotePath(path string) (string, error) {
+	name := filepath.Clean(path)
+	if name == "." || path == "" || filepath.IsAbs(name) || strings.HasPrefix(path, "~/") ||
+		name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("'%s' is not a note name; use a path inside the notes folder", path)
+	}
+	return name, nil
+}
 
-	// Substitute variables
-	finalContent := substituteVariables(templateContent, vars)
+// applyTemplate creates a note from a template and returns its path without
+// the .md extension. An empty filename uses the template's filename pattern.
+func applyTemplate(templateName, filename, title string, answers map[string]string) (string, error) {
+	return writeTemplateNote(templateName, filename, templateContext{
+		Now:     time.Now(),
+		Title:   title,
+		Answers: answers,
+	}, true)
+}
+
+// writeTemplateNote renders a template into a note. With unique set, a name
+// generated from the filename pattern never replaces an existing note.
+func writeTemplateNote(templateName, filename string, ctx templateContext, unique bool) (string, error) {
+	target, finalContent, err := planTemplateNote(templateName, filename, ctx, unique)
+	if err != nil {
+		return "", err
+	}
 
 	// Create file
-	filePath := filename + ".md"
-	if err := os.WriteFile(filePath, []byte(finalContent), 0644); err != nil {
-		return fmt.Errorf("error creating file: %v", err)
+	if dir := filepath.Dir(target); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return "", fmt.Errorf("error creating folder: %v", err)
+		}
+	}
+	if err := os.WriteFile(target+".md", []byte(finalContent), 0644); err != nil {
+		return "", fmt.Errorf("error creating file: %v", err)
 	}
 
-	return nil
+	return target, nil
 }
 
-// listTemplates shows all available templates
-func listTemplates() {
-	fmt.Println("\n📋 Built-in Templates:\n")
+// planTemplateNote renders a template and works out the note it goes in,
+// without writing anything
+func planTemplateNote(templateName, filename string, ctx templateContext, unique bool) (string, string, error) {
+	// Render the template
+	finalContent, meta, err := renderNoteTemplate(templateName, ctx)
+	if err != nil {
+		return "", "", err
+	}
 
-	templates := []string{"daily", "meeting", "project", "weekly", "idea", "grateful"}
-	for _, name := range templates {
-		desc := getTemplateDescription(name)
-		fmt.Printf("  %-15s - %s\n", name, desc)
+	target, err := templateTargetPath(templateName, meta, filename, ctx)
+	if err != nil {
+		return "", "", err
 	}
 
-	// Check for custom templates
-	templateDir := getTemplateDir()
-	if _, err := os.Stat(templateDir); err == nil {
-		customTemplates, _ := filepath.Glob(filepath.Join(templateDir, "*.md"))
-		if len(customTemplates) > 0 {
-			fmt.Println("\n📝 Custom Templates:\n")
-			for _, tmpl := range customTemplates {
-				name := strings.TrimSuffix(filepath.Base(tmpl), ".md")
-				fmt.Printf("  %s\n", name)
+	// Names generated from a pattern never replace an existing note
+	if filename == "" && unique {
+		base := target
+		for n := 2; ; n++ {
+			if _, err := os.Stat(target + ".md"); os.IsNotExist(err) {
+				break
 			}
+			target = fmt.Sprintf("%s-%d", base, n)
 		}
 	}
 
-	fmt.Println("\n💡 Usage: notetype template <template-name> <filename> <title>")
-	fmt.Println("   Example: notetype template daily today \"My Day\"")
+	return target, finalContent, nil
 }
 
-func getTemplateDescription(name string) string {
-	descriptions := map[string]string{
-		"daily":    "Daily journal with morning/evening sections",
-		"meeting":  "Meeting notes with agenda and action items",
-		"project":  "Project planning template",
-		"weekly":   "Weekly review and reflection",
-		"idea":     "Capture and develop ideas",
-		"grateful": "Gratitude journal entry",
+// templateDescription returns the description shown in template lists
+func templateDescription(name string, meta templateMeta) string {
+	desc := meta.Description
+	if desc == "" {
+		desc = "Custom template"
+	}
+
+	_, builtIn := builtInTemplates[name]
+	if builtIn && customTemplateExists(name) {
+		desc += " (overridden)"
 	}
-	return descriptions[name]
+	return desc
+}
+
+// listTemplates shows all available templates, grouped by category
+func listTemplates() {
+	categories := make(map[string][]string)
+	var order []string
+	for _, name := range availableTemplates() {
+		category := getTemplateMeta(name).Category
+		if category == "" {
+			category = "Other"
+		}
+		if _, seen := categories[category]; !seen {
+			order = append(order, category)
+		}
+		categories[category] = append(categories[category], name)
+	}
+	sort.Strings(order)
+
+	for _, category := range order {
+		fmt.Printf("\n📋 %s:\n\n", category)
+		for _, name := range categories[category] {
+			meta := getTemplateMeta(name)
+			fmt.Printf("  %-15s - %s\n", name, templateDescription(name, meta))
+
+			var details []string
+			if meta.Filename != "" {
+				details = append(details, "file: "+meta.Filename)
+			}
+			if meta.Folder != "" {
+				details = append(details, "folder: "+meta.Folder)
+			}
+			if len(meta.Tags) > 0 {
+				details = append(details, "tags: #"+strings.Join(meta.Tags, " #"))
+			}
+			if len(details) > 0 {
+				fmt.Printf("  %-15s   %s\n", "", strings.Join(details, " • "))
+			}
+		}
+	}
+
+	fmt.Println("\n💡 Usage: notetype template <template-name> [filename] <title>")
+	fmt.Println("   Example: notetype template meeting \"Sprint planning\"")
 }
 
 // showTemplate displays a template content
 func showTemplate(templateName string) {
-	content, exists := builtInTemplates[templateName]
-	if !exists {
-		templatePath := filepath.Join(getTemplateDir(), templateName+".md")
-		contentBytes, err := os.ReadFile(templatePath)
-		if err != nil {
-			fmt.Printf("❌ Template '%s' not found\n", templateName)
-			return
-		}
-		content = string(contentBytes)
+	content, err := getTemplateSource(templateName)
+	if err != nil {
+		fmt.Printf("❌ Template '%s' not found\n", templateName)
+		return
 	}
 
 	fmt.Printf("\n📄 Template: %s\n", templateName)
@@ -314,13 +610,109 @@ func saveCustomTemplate(name, content string) error {
 		return err
 	}
 
-	templatePath := filepath.Join(getTemplateDir(), name+".md")
-	return os.WriteFile(templatePath, []byte(content), 0644)
+	return os.WriteFile(getCustomTemplatePath(name), []byte(content), 0644)
+}
+
+// removeCustomTemplate deletes a custom template
+func removeCustomTemplate(name string) error {
+	if !templateNameRe.MatchString(name) {
+		return fmt.Errorf("invalid template name '%s'", name)
+	}
+	if !customTemplateExists(name) {
+		if _, builtIn := builtInTemplates[name]; builtIn {
+			return fmt.Errorf("'%s' is a built-in template and cannot be removed", name)
+		}
+		return fmt.Errorf("template '%s' not found", name)
+	}
+	return os.Remove(getCustomTemplatePath(name))
+}
+
+// editTemplate opens a custom template in $EDITOR. Editing a built-in template
+// copies it to the templates directory first, which overrides the built-in.
+func editTemplate(name string) error {
+	if !templateNameRe.MatchString(name) {
+		return fmt.Errorf("invalid template name '%s'", name)
+	}
+	if !customTemplateExists(name) {
+		content, builtIn := builtInTemplates[name]
+		if !builtIn {
+			return fmt.Errorf("template '%s' not found. Create it with 'notetype template add %s'", name, name)
+		}
+		if err := saveCustomTemplate(name, content); err != nil {
+			return fmt.Errorf("error copying built-in template: %v", err)
+		}
+		fmt.Printf("📋 Copied built-in '%s' to %s (it now overrides the built-in)\n", name, getCustomTemplatePath(name))
+	}
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	editCmd := exec.Command(editor, getCustomTemplatePath(name))
+	editCmd.Stdin = os.Stdin
+	editCmd.Stdout = os.Stdout
+	editCmd.Stderr = os.Stderr
+	if err := editCmd.Run(); err != nil {
+		return fmt.Errorf("error running %s: %v", editor, err)
+	}
+	return nil
+}
+
+// templateBundle is the single-file format used by template export and import
+type templateBundle struct {
+	Version   int               `json:"version"`
+	Templates map[string]string `json:"templates"`
+}
+
+// exportTemplates bundles the named templates, or every custom template when none are given
+func exportTemplates(names []string) (templateBundle, error) {
+	if len(names) == 0 {
+		names = customTemplateNames()
+	}
+
+	bundle := templateBundle{Version: 1, Templates: make(map[string]string)}
+	for _, name := range names {
+		content, err := getTemplateSource(name)
+		if err != nil {
+			return bundle, err
+		}
+		bundle.Templates[name] = content
+	}
+	return bundle, nil
+}
+
+// importTemplates saves the templates of a bundle as custom templates,
+// skipping existing ones unless force is set
+func importTemplates(bundle templateBundle, force bool) ([]string, []string, error) {
+	var imported, skipped []string
+
+	names := make([]string, 0, len(bundle.Templates))
+	for name := range bundle.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !templateNameRe.MatchString(name) {
+			return imported, skipped, fmt.Errorf("invalid template name '%s' in bundle", name)
+		}
+		if customTemplateExists(name) && !force {
+			skipped = append(skipped, name)
+			continue
+		}
+		if err := saveCustomTemplate(name, bundle.Templates[name]); err != nil {
+			return imported, skipped, err
+		}
+		imported = append(imported, name)
+	}
+
+	return imported, skipped, nil
 }
 
 // templateCmd represents the template command
 var templateCmd = &cobra.Command{
-	Use:   "template <template-name> <filename> <title>",
+	Use:   "template <template-name> [filename] <title>",
 	Short: "Create notes from templates",
 	Long: `Create new notes using pre-defined templates.
 
@@ -332,10 +724,50 @@ Built-in templates:
   idea     - Idea capture template
   grateful - Gratitude journal template
 
+Templates are rendered with Go's text/template engine:
+  {{date}}  {{datetime}}  {{time}}  {{year}}  {{month}}  {{day}}  {{title}}
+  {{slug title}}                               "Sprint planning" → sprint-planning
+  {{date "+1d"}}  {{date "-1w" "Mon Jan 2"}}   Date arithmetic and formats
+  {{if .Title}}...{{else}}...{{end}}           Conditionals
+  {{range list "Mon" "Wed" "Fri"}}- {{.}}{{end}} Loops
+  {{include "other-template"}}                 Include another template
+  {{prompt "Attendees"}}                       Ask a question when creating
+
+Prompts are asked interactively, or answered up front with --set.
+
+A template can start with a front-matter header describing it:
+  ---
+  description: Meeting notes with agenda and action items
+  filename: meeting-{{date}}-{{slug title}}
+  folder: meetings
+  tags: meeting, work
+  category: Work
+  recur: 0 9 * * 1-5
+  ---
+
+Without a filename argument the note is named from the 'filename' pattern.
+The note is created in 'folder', which must be inside the current directory:
+absolute folders, '~/' and '..' are refused. The default 'tags' are added
+when the note doesn't mention them already.
+See 'notetype recur --help' for 'recur'.
+
+Custom templates live in ~/.notetype/templates. A custom template with the
+same name as a built-in one overrides it; removing it restores the built-in.
+
+Managing templates:
+  notetype template add standup --from-file standup.md
+  notetype template add retro --from-note last-retro
+  notetype template edit meeting        # Opens $EDITOR (overrides the built-in)
+  notetype template rm standup
+  notetype template export -o templates.json
+  notetype template import templates.json
+
 Examples:
+  notetype template meeting "Sprint planning"   # meeting-2024-01-15-sprint-planning.md
   notetype template daily today "My Daily Entry"
   notetype template meeting standup "Team Standup"
   notetype template project project-x "Project X"
+  notetype template meeting sync "Sync" --set Attendees="Ana, Bo"
 `,
 	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -345,21 +777,38 @@ Examples:
 			return
 		}
 
-		if len(args) < 3 {
-			fmt.Println("❌ Usage: notetype template <template-name> <filename> <title>")
+		if len(args) > 3 {
+			fmt.Println("❌ Usage: notetype template <template-name> [filename] <title>")
 			return
 		}
 
 		templateName := args[0]
-		filename := args[1]
-		title := args[2]
+		filename, title := "", args[len(args)-1]
+		if len(args) == 3 {
+			filename = args[1]
+		}
 
-		if err := applyTemplate(templateName, filename, title); err != nil {
+		// Ask for any {{prompt}} placeholders not answered with --set
+		templateContent, err := getTemplateContent(templateName)
+		if err != nil {
 			fmt.Printf("❌ Error: %v\n", err)
 			return
 		}
+		prompts, err := templatePrompts(templateContent)
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+		answers, _ := cmd.Flags().GetStringToString("set")
+		answers = askTemplatePrompts(prompts, answers, os.Stdin)
 
-		fmt.Printf("✅ Created '%s.md' from template '%s'\n", filename, templateName)
+		notePath, err := applyTemplate(templateName, filename, title, answers)
+		if err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+			return
+		}
+
+		fmt.Printf("✅ Created '%s.md' from template '%s'\n", notePath, templateName)
 		fmt.Printf("💡 Edit it with: notetype\n")
 	},
 }
@@ -383,8 +832,196 @@ var templateShowCmd = &cobra.Command{
 	},
 }
 
+// templateAddCmd creates a custom template
+var templateAddCmd = &cobra.Command{
+	Use:   "add <template-name>",
+	Short: "Create a custom template from a file, a note or typed input",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		if !templateNameRe.MatchString(name) {
+			fmt.Println("❌ Template names may only contain letters, digits, '-' and '_'")
+			os.Exit(1)
+		}
+
+		force, _ := cmd.Flags().GetBool("force")
+		if customTemplateExists(name) && !force {
+			fmt.Printf("❌ Template '%s' already exists. Use --force to replace it or 'notetype template edit %s'\n", name, name)
+			os.Exit(1)
+		}
+
+		fromFile, _ := cmd.Flags().GetString("from-file")
+		fromNote, _ := cmd.Flags().GetString("from-note")
+
+		var content string
+		switch {
+		case fromFile != "" && fromNote != "":
+			fmt.Println("❌ Use either --from-file or --from-note, not both")
+			os.Exit(1)
+		case fromFile != "":
+			data, err := os.ReadFile(fromFile)
+			if err != nil {
+				fmt.Printf("❌ Error reading file: %v\n", err)
+				os.Exit(1)
+			}
+			content = string(data)
+		case fromNote != "":
+			data, err := os.ReadFile(fromNote + ".md")
+			if err != nil {
+				fmt.Printf("❌ Error reading note: %v\n", err)
+				os.Exit(1)
+			}
+			content = string(data)
+		default:
+			fmt.Println("\n✍️  Enter the template (press Ctrl+D or type 'EOF' on a new line to finish):")
+			fmt.Println(strings.Repeat("-", 70))
+			content = readMultilineInput(os.Stdin)
+			fmt.Println(strings.Repeat("-", 70))
+		}
+
+		if strings.TrimSpace(content) == "" {
+			fmt.Println("❌ no content provided")
+			os.Exit(1)
+		}
+		_, body, err := parseTemplateFrontMatter(content)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		if _, err := templatePrompts(body); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		if err := saveCustomTemplate(name, content); err != nil {
+			fmt.Printf("❌ Error saving template: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("✅ Saved template '%s'\n", name)
+		if _, builtIn := builtInTemplates[name]; builtIn {
+			fmt.Printf("💡 It overrides the built-in '%s' template. Remove it to restore the built-in\n", name)
+		}
+	},
+}
+
+// templateEditCmd edits a template in $EDITOR
+var templateEditCmd = &cobra.Command{
+	Use:   "edit <template-name>",
+	Short: "Edit a template in $EDITOR",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := editTemplate(args[0]); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Saved template '%s'\n", args[0])
+	},
+}
+
+// templateRemoveCmd removes a custom template
+var templateRemoveCmd = &cobra.Command{
+	Use:     "rm <template-name>",
+	Aliases: []string{"remove"},
+	Short:   "Remove a custom template",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		if err := removeCustomTemplate(name); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("✅ Removed template '%s'\n", name)
+		if _, builtIn := builtInTemplates[name]; builtIn {
+			fmt.Printf("💡 The built-in '%s' template is in use again\n", name)
+		}
+	},
+}
+
+// templateExportCmd writes templates to a single bundle file
+var templateExportCmd = &cobra.Command{
+	Use:   "export [template-name...]",
+	Short: "Export templates to a single-file bundle",
+	Long: `Export templates to a single JSON bundle. Without names, every custom
+template is exported. Without --output, the bundle is printed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		bundle, err := exportTemplates(args)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		if len(bundle.Templates) == 0 {
+			fmt.Println("📝 No custom templates to export")
+			return
+		}
+
+		data, err := json.MarshalIndent(bundle, "", "  ")
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		if output == "" {
+			fmt.Println(string(data))
+			return
+		}
+		if err := os.WriteFile(output, data, 0644); err != nil {
+			fmt.Printf("❌ Error writing bundle: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Exported %d templates to %s\n", len(bundle.Templates), output)
+	},
+}
+
+// templateImportCmd reads templates from a bundle file
+var templateImportCmd = &cobra.Command{
+	Use:   "import <bundle-file>",
+	Short: "Import templates from a bundle",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			fmt.Printf("❌ Error reading bundle: %v\n", err)
+			os.Exit(1)
+		}
+
+		var bundle templateBundle
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			fmt.Printf("❌ Invalid template bundle: %v\n", err)
+			os.Exit(1)
+		}
+
+		force, _ := cmd.Flags().GetBool("force")
+		imported, skipped, err := importTemplates(bundle, force)
+		for _, name := range imported {
+			fmt.Printf("✅ Imported '%s'\n", name)
+		}
+		for _, name := range skipped {
+			fmt.Printf("⏭️  Skipped '%s' (already exists, use --force to replace)\n", name)
+		}
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
 func init() {
+	templateCmd.Flags().StringToString("set", nil, "Answer template prompts up front (name=value)")
+	templateAddCmd.Flags().String("from-file", "", "Read the template from a file")
+	templateAddCmd.Flags().String("from-note", "", "Use an existing note as the template")
+	templateAddCmd.Flags().BoolP("force", "f", false, "Replace an existing custom template")
+	templateExportCmd.Flags().StringP("output", "o", "", "Write the bundle to a file instead of stdout")
+	templateImportCmd.Flags().BoolP("force", "f", false, "Replace existing custom templates")
+
 	templateCmd.AddCommand(templateListCmd)
 	templateCmd.AddCommand(templateShowCmd)
+	templateCmd.AddCommand(templateAddCmd)
+	templateCmd.AddCommand(templateEditCmd)
+	templateCmd.AddCommand(templateRemoveCmd)
+	templateCmd.AddCommand(templateExportCmd)
+	templateCmd.AddCommand(templateImportCmd)
 	rootCmd.AddCommand(templateCmd)
 }
@@ -3,8 +3,12 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
@@ -25,7 +29,28 @@ type Theme struct {
 	BackgroundAlt string `json:"background_alt"`
 }
 
-// Available themes
+var hexColorRe = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+var themeNameRe = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// Built-in theme names, in the order they are listed
+var builtInThemeNames = []string{
+	"violet", "dracula", "nord", "gruvbox", "solarized", "monokai", "tokyo", "catppuccin",
+	"violet-light", "solarized-light", "catppuccin-latte", "gruvbox-light",
+}
+
+// autoTheme is the theme setting that follows the terminal background
+const autoTheme = "auto"
+
+// Dark and light variants of the same theme, used by the auto setting
+var themePairs = [][2]string{
+	{"violet", "violet-light"},
+	{"solarized", "solarized-light"},
+	{"catppuccin", "catppuccin-latte"},
+	{"gruvbox", "gruvbox-light"},
+}
+
+// Built-in themes
 var themes = map[string]Theme{
 	"violet": {
 		Name:          "Violet (Default)",
@@ -131,6 +156,58 @@ var themes = map[string]Theme{
 		Background:    "#1E1E2E",
 		BackgroundAlt: "#313244",
 	},
+	"violet-light": {
+		Name:          "Violet Light",
+		Primary:       "#6D28D9",
+		Secondary:     "#7C3AED",
+		Accent:        "#8B5CF6",
+		Success:       "#059669",
+		Warning:       "#D97706",
+		Error:         "#DC2626",
+		Text:          "#1F2937",
+		Muted:         "#6B7280",
+		Background:    "#F9FAFB",
+		BackgroundAlt: "#E5E7EB",
+	},
+	"solarized-light": {
+		Name:          "Solarized Light",
+		Primary:       "#268BD2",
+		Secondary:     "#2AA198",
+		Accent:        "#6C71C4",
+		Success:       "#859900",
+		Warning:       "#B58900",
+		Error:         "#DC322F",
+		Text:          "#586E75",
+		Muted:         "#93A1A1",
+		Background:    "#FDF6E3",
+		BackgroundAlt: "#EEE8D5",
+	},
+	"catppuccin-latte": {
+		Name:          "Catppuccin Latte",
+		Primary:       "#8839EF",
+		Secondary:     "#EA76CB",
+		Accent:        "#04A5E5",
+		Success:       "#40A02B",
+		Warning:       "#DF8E1D",
+		Error:         "#D20F39",
+		Text:          "#4C4F69",
+		Muted:         "#9CA0B0",
+		Background:    "#EFF1F5",
+		BackgroundAlt: "#E6E9EF",
+	},
+	"gruvbox-light": {
+		Name:          "Gruvbox Light",
+		Primary:       "#8F3F71",
+		Secondary:     "#B16286",
+		Accent:        "#427B58",
+		Success:       "#79740E",
+		Warning:       "#B57614",
+		Error:         "#9D0006",
+		Text:          "#3C3836",
+		Muted:         "#928374",
+		Background:    "#FBF1C7",
+		BackgroundAlt: "#EBDBB2",
+	},
 }
 
 // getThemeConfigPath returns the path to theme config
@@ -142,25 +219,288 @@ func getThemeConfigPath() string {
 	return filepath.Join(home, ".notetype", "theme.json")
 }
 
-// loadTheme loads the current theme from config
-func loadTheme() Theme {
-	configPath := getThemeConfigPath()
-	data, err := os.ReadFile(configPath)
+// getThemesDir returns the directory of user-defined themes
+func getThemesDir() string {
+	home, err := os.UserHomeDir()
 	if err != nil {
-		// Return default theme
-		return themes["violet"]
+		return "./themes"
+	}
+	return filepath.Join(home, ".notetype", "themes")
+}
+
+// getCustomThemePath returns the file of a user-defined theme
+func getCustomThemePath(name string) string {
+	return filepath.Join(getThemesDir(), name+".json")
+}
+
+// themeColors returns the colors of a theme by field name
+func themeColors(theme Theme) [][2]string {
+	return [][2]string{
+		{"primary", theme.Primary},
+		{"secondary", theme.Secondary},
+		{"accent", theme.Accent},
+		{"success", theme.Success},
+		{"warning", theme.Warning},
+		{"error", theme.Error},
+		{"text", theme.Text},
+		{"muted", theme.Muted},
+		{"background", theme.Background},
+		{"background_alt", theme.BackgroundAlt},
+	}
+}
+
+// setThemeColor changes one color of a theme by its field name
+func setThemeColor(theme *Theme, field, value string) error {
+	switch strings.ToLower(field) {
+	case "primary":
+		theme.Primary = value
+	case "secondary":
+		theme.Secondary = value
+	case "accent":
+		theme.Accent = value
+	case "success":
+		theme.Success = value
+	case "warning":
+		theme.Warning = value
+	case "error":
+		theme.Error = value
+	case "text":
+		theme.Text = value
+	case "muted":
+		theme.Muted = value
+	case "background":
+		theme.Background = value
+	case "background_alt", "background-alt":
+		theme.BackgroundAlt = value
+	default:
+		return fmt.Errorf("unknown color '%s'", field)
+	}
+	return nil
+}
+
+// validateTheme checks that every color of a theme is a hex color
+func validateTheme(theme Theme) error {
+	for _, color := range themeColors(theme) {
+		if color[1] == "" {
+			return fmt.Errorf("%s color is missing", color[0])
+		}
+		if !hexColorRe.MatchString(color[1]) {
+			return fmt.Errorf("%s color '%s' is not a hex color like #7C3AED", color[0], color[1])
+		}
+	}
+	return nil
+}
+
+// loadCustomThemes reads the user-defined themes from disk. Themes that
+// can't be read or don't validate are returned as errors by name.
+func loadCustomThemes() (map[string]Theme, map[string]error) {
+	custom := make(map[string]Theme)
+	invalid := make(map[string]error)
+
+	files, _ := filepath.Glob(filepath.Join(getThemesDir(), "*.json"))
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			invalid[name] = err
+			continue
+		}
+
+		var theme Theme
+		if err := json.Unmarshal(data, &theme); err != nil {
+			invalid[name] = fmt.Errorf("invalid JSON: %v", err)
+			continue
+		}
+		if err := validateTheme(theme); err != nil {
+			invalid[name] = err
+			continue
+		}
+		if theme.Name == "" {
+			theme.Name = name
+		}
+		custom[name] = theme
+	}
+
+	return custom, invalid
+}
+
+// allThemes returns the built-in themes merged with the user-defined ones.
+// A user-defined theme with the name of a built-in one overrides it.
+func allThemes() map[string]Theme {
+	merged := make(map[string]Theme, len(themes))
+	for name, theme := range themes {
+		merged[name] = theme
+	}
+
+	custom, _ := loadCustomThemes()
+	for name, theme := range custom {
+		merged[name] = theme
+	}
+	return merged
+}
+
+// themeNames returns the built-in theme names followed by the custom ones
+func themeNames() []string {
+	names := append([]string{}, builtInThemeNames...)
+
+	custom, _ := loadCustomThemes()
+	var extra []string
+	for name := range custom {
+		if _, builtIn := themes[name]; !builtIn {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	return append(names, extra...)
+}
+
+// isAutoTheme reports whether a theme setting is "auto" or "auto:<theme>"
+func isAutoTheme(name string) bool {
+	return name == autoTheme || strings.HasPrefix(name, autoTheme+":")
+}
+
+// themePair returns the dark and light variants a theme belongs to
+func themePair(name string) (string, string, bool) {
+	for _, pair := range themePairs {
+		if name == pair[0] || name == pair[1] {
+			return pair[0], pair[1], true
+		}
+	}
+	return "", "", false
+}
+
+// resolveAutoTheme picks the dark or light variant of an auto setting from
+// the terminal background. "auto" uses the violet pair.
+func resolveAutoTheme(setting string) string {
+	family := strings.TrimPrefix(strings.TrimPrefix(setting, autoTheme), ":")
+	if family == "" {
+		family = "violet"
+	}
+
+	dark, light, ok := themePair(family)
+	if !ok {
+		return family
+	}
+	if lipgloss.HasDarkBackground() {
+		return dark
+	}
+	return light
+}
+
+// findTheme looks up a built-in or user-defined theme, or resolves an auto setting
+func findTheme(name string) (Theme, bool) {
+	if isAutoTheme(name) {
+		theme, exists := allThemes()[resolveAutoTheme(name)]
+		if exists {
+			theme.Name = "Auto - " + theme.Name
+		}
+		return theme, exists
+	}
+
+	theme, exists := allThemes()[name]
+	return theme, exists
+}
+
+// noColor reports whether the user asked for no colors (https://no-color.org)
+func noColor() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
+
+// parseHexColor returns the red, green and blue parts of a #RGB or #RRGGBB color
+func parseHexColor(hex string) (float64, float64, float64, bool) {
+	if !hexColorRe.MatchString(hex) {
+		return 0, 0, 0, false
+	}
+
+	digits := hex[1:]
+	if len(digits) == 3 {
+		digits = string([]byte{digits[0], digits[0], digits[1], digits[1], digits[2], digits[2]})
+	}
+
+	var r, g, b int
+	fmt.Sscanf(digits, "%02x%02x%02x", &r, &g, &b)
+	return float64(r) / 255, float64(g) / 255, float64(b) / 255, true
+}
+
+// relativeLuminance is the WCAG relative luminance of a hex color
+func relativeLuminance(hex string) float64 {
+	r, g, b, ok := parseHexColor(hex)
+	if !ok {
+		return 0
+	}
+
+	linear := func(c float64) float64 {
+		if c <= 0.03928 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+}
+
+// contrastRatio is the WCAG contrast ratio between two hex colors
+func contrastRatio(a, b string) float64 {
+	la, lb := relativeLuminance(a), relativeLuminance(b)
+	return (math.Max(la, lb) + 0.05) / (math.Min(la, lb) + 0.05)
+}
+
+// minBoldContrast is the WCAG AA contrast ratio for bold text
+const minBoldContrast = 3.0
+
+// contrastColor picks the first candidate that is readable as bold text on a
+// background color, falling back to whichever of white or black reads better
+func contrastColor(background string, candidates ...string) lipgloss.Color {
+	for _, candidate := range candidates {
+		if contrastRatio(background, candidate) >= minBoldContrast {
+			return lipgloss.Color(candidate)
+		}
+	}
+
+	if contrastRatio(background, "#FFFFFF") >= contrastRatio(background, "#000000") {
+		return lipgloss.Color("#FFFFFF")
+	}
+	return lipgloss.Color("#000000")
+}
+
+// currentThemeName returns the configured theme setting
+func currentThemeName() string {
+	data, err := os.ReadFile(getThemeConfigPath())
+	if err != nil {
+		return "violet"
 	}
 
 	var themeName string
 	if err := json.Unmarshal(data, &themeName); err != nil {
-		return themes["violet"]
+		return "violet"
 	}
 
-	if theme, exists := themes[themeName]; exists {
-		return theme
+	if _, exists := findTheme(themeName); !exists {
+		return "violet"
 	}
+	return themeName
+}
 
-	return themes["violet"]
+// loadTheme loads the current theme from config
+func loadTheme() Theme {
+	theme, _ := findTheme(currentThemeName())
+	return theme
+}
+
+// saveCustomTheme writes a user-defined theme to the themes directory
+func saveCustomTheme(name string, theme Theme) error {
+	if err := validateTheme(theme); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(getThemesDir(), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(theme, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(getCustomThemePath(name), append(data, '\n'), 0644)
 }
 
 // saveTheme saves the current theme to config
@@ -180,96 +520,50 @@ func saveTheme(themeName string) error {
 	return os.WriteFile(configPath, data, 0644)
 }
 
-// applyTheme applies a theme to the TUI styles
-func applyThemeToStyles(theme Theme) {
-	primaryColor = lipgloss.Color(theme.Primary)
-	secondaryColor = lipgloss.Color(theme.Secondary)
-	accentColor = lipgloss.Color(theme.Accent)
-	successColor = lipgloss.Color(theme.Success)
-	warningColor = lipgloss.Color(theme.Warning)
-	errorColor = lipgloss.Color(theme.Error)
-	textColor = lipgloss.Color(theme.Text)
-	mutedColor = lipgloss.Color(theme.Muted)
-	bgColor = lipgloss.Color(theme.Background)
-	bgAltColor = lipgloss.Color(theme.BackgroundAlt)
-
-	// Rebuild styles with new colors
-	titleStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FFFFFF")).
-		Background(primaryColor).
-		Bold(true).
-		Padding(0, 2).
-		MarginBottom(1)
-
-	statusBarStyle = lipgloss.NewStyle().
-		Foreground(textColor).
-		Background(bgAltColor).
-		Padding(0, 1)
-
-	statusStyle = lipgloss.NewStyle().
-		Foreground(mutedColor).
-		Italic(true)
-
-	helpStyle = lipgloss.NewStyle().
-		Foreground(mutedColor).
-		MarginTop(1)
-
-	selectedItemStyle = lipgloss.NewStyle().
-		Foreground(primaryColor).
-		Bold(true).
-		PaddingLeft(2)
-
-	normalItemStyle = lipgloss.NewStyle().
-		Foreground(textColor).
-		PaddingLeft(2)
-
-	panelStyle = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(primaryColor).
-		Padding(1, 2).
-		MarginRight(2)
-
-	activePanelStyle = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(accentColor).
-		Padding(1, 2).
-		MarginRight(2)
-
-	editorStyle = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(secondaryColor).
-		Padding(1)
-
-	activeButtonStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FFFFFF")).
-		Background(primaryColor).
-		Bold(true).
-		Padding(0, 3).
-		MarginRight(2)
-
-	inactiveButtonStyle = lipgloss.NewStyle().
-		Foreground(mutedColor).
-		Background(bgAltColor).
-		Padding(0, 3).
-		MarginRight(2)
-}
-
 // listAvailableThemes shows all available themes
 func listAvailableThemes() {
-	currentTheme := loadTheme()
+	current := currentThemeName()
+	all := allThemes()
+	custom, invalid := loadCustomThemes()
 
-	fmt.Println("\n🎨 Available Themes:\n")
+	fmt.Print("\n🎨 Available Themes:\n\n")
 
-	themeNames := []string{"violet", "dracula", "nord", "gruvbox", "solarized", "monokai", "tokyo", "catppuccin"}
+	if isAutoTheme(current) {
+		theme, _ := findTheme(current)
+		fmt.Printf("✓ %-15s - %s\n", current, theme.Name)
+	} else {
+		fmt.Printf("  %-15s - Follows the terminal background\n", autoTheme)
+	}
+	fmt.Println("   Pairs: violet, solarized, catppuccin, gruvbox (use auto:<theme>)")
+	fmt.Println()
 
-	for _, name := range themeNames {
-		theme := themes[name]
+	for _, name := range themeNames() {
+		theme := all[name]
 		indicator := "  "
-		if theme.Name == currentTheme.Name {
+		if name == current {
 			indicator = "✓ "
 		}
 		fmt.Printf("%s%-15s - %s\n", indicator, name, theme.Name)
 		fmt.Printf("   Primary: %s, Accent: %s\n", theme.Primary, theme.Accent)
+		if _, isCustom := custom[name]; isCustom {
+			if _, builtIn := themes[name]; builtIn {
+				fmt.Printf("   📍 %s (overrides built-in)\n", getCustomThemePath(name))
+			} else {
+				fmt.Printf("   📍 %s\n", getCustomThemePath(name))
+			}
+		}
+		fmt.Println()
+	}
+
+	if len(invalid) > 0 {
+		var names []string
+		for name := range invalid {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("⚠️  Skipped %s: %v\n", getCustomThemePath(name), invalid[name])
+		}
 		fmt.Println()
 	}
 
@@ -277,26 +571,83 @@ func listAvailableThemes() {
 	fmt.Println("   Then restart the TUI to see changes")
 }
 
+// renderThemeSwatches renders a colored swatch for every color of a theme
+func renderThemeSwatches(theme Theme) string {
+	labelStyle := lipgloss.NewStyle().Width(16)
+
+	var lines []string
+	for _, color := range themeColors(theme) {
+		swatch := lipgloss.NewStyle().Background(lipgloss.Color(color[1])).Render("      ")
+		if noColor() {
+			swatch = "······"
+		}
+		lines = append(lines, swatch+"  "+labelStyle.Render(color[0])+color[1])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderThemeMock renders a miniature TUI in a theme. It uses the same
+// styles as the TUI, so the mock looks exactly like the real screens.
+func renderThemeMock(theme Theme, width int) string {
+	s := NewStyles(theme)
+	if width < 30 {
+		width = 30
+	}
+	inner := width - 4
+
+	items := lipgloss.JoinVertical(
+		lipgloss.Left,
+		s.SelectedItem.Render("▸ 📝 Sprint planning"),
+		s.NormalItem.Render("  📔 2024-01-15"),
+		s.NormalItem.Render("  🏷️  #work #meeting"),
+		s.MutedText.PaddingLeft(2).Render("  Updated 5 minutes ago"),
+	)
+
+	messages := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		s.SuccessText.Render("✅ Saved  "),
+		s.WarningText.Render("⚠️  Unsaved  "),
+		s.ErrorText.Render("❌ Failed"),
+	)
+
+	buttons := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		s.ActiveButton.Render("💾 Save"),
+		s.InactiveButton.Render("❌ Cancel"),
+	)
+
+	screen := lipgloss.JoinVertical(
+		lipgloss.Left,
+		s.Title.Width(inner).Render("✨ NoteType"),
+		s.Panel.Width(inner-4).Render(items),
+		messages,
+		"",
+		buttons,
+		"",
+		s.StatusBar.Width(inner).Render("📝 Notes • 3 notes"),
+		s.Status.Render("Press ? for help"),
+	)
+
+	return lipgloss.NewStyle().
+		Background(s.Background).
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(s.Muted).
+		Padding(0, 1).
+		Render(screen)
+}
+
 // previewTheme shows a preview of a theme
 func previewTheme(themeName string) {
-	theme, exists := themes[themeName]
+	theme, exists := findTheme(themeName)
 	if !exists {
 		fmt.Printf("❌ Theme '%s' not found\n", themeName)
 		return
 	}
 
 	fmt.Printf("\n🎨 Theme Preview: %s\n\n", theme.Name)
-
-	// Color samples
-	fmt.Printf("  Primary:    %s ████\n", theme.Primary)
-	fmt.Printf("  Secondary:  %s ████\n", theme.Secondary)
-	fmt.Printf("  Accent:     %s ████\n", theme.Accent)
-	fmt.Printf("  Success:    %s ████\n", theme.Success)
-	fmt.Printf("  Warning:    %s ████\n", theme.Warning)
-	fmt.Printf("  Error:      %s ████\n", theme.Error)
-	fmt.Printf("  Text:       %s ████\n", theme.Text)
-	fmt.Printf("  Muted:      %s ████\n", theme.Muted)
-	fmt.Printf("  Background: %s ████\n", theme.Background)
+	fmt.Println(renderThemeSwatches(theme))
+	fmt.Println()
+	fmt.Println(renderThemeMock(theme, 60))
 	fmt.Println()
 }
 
@@ -316,10 +667,29 @@ Available themes:
   tokyo       - Tokyo Night
   catppuccin  - Catppuccin mocha
 
+Light themes:
+  violet-light      - Light violet
+  solarized-light   - Solarized light
+  catppuccin-latte  - Catppuccin latte
+  gruvbox-light     - Gruvbox light
+
+Automatic:
+  auto              - Violet or Violet Light, following the terminal background
+  auto:<theme>      - The dark or light variant of solarized, catppuccin or gruvbox
+
+Set NO_COLOR to turn colors off; highlights then use reverse video.
+
+Custom themes are JSON files in ~/.notetype/themes, named after the theme.
+They are listed next to the built-in ones, and one with the name of a
+built-in theme overrides it. Colors must be hex colors such as #7C3AED.
+
 Examples:
   notetype theme list           # List all themes
   notetype theme set dracula    # Set Dracula theme
   notetype theme preview nord   # Preview Nord theme
+  notetype theme set auto:gruvbox   # Gruvbox, light or dark to match the terminal
+  notetype theme create mine --from dracula
+  notetype theme create mine --from nord --color primary=#FF8800 --force
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		currentTheme := loadTheme()
@@ -345,8 +715,19 @@ var themeSetCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		themeName := args[0]
 
-		if _, exists := themes[themeName]; !exists {
+		if isAutoTheme(themeName) && themeName != autoTheme {
+			if _, _, paired := themePair(strings.TrimPrefix(themeName, autoTheme+":")); !paired {
+				fmt.Printf("❌ '%s' has no light and dark variants. Use one of: violet, solarized, catppuccin, gruvbox\n", strings.TrimPrefix(themeName, autoTheme+":"))
+				return
+			}
+		}
+
+		theme, exists := findTheme(themeName)
+		if !exists {
 			fmt.Printf("❌ Theme '%s' not found\n", themeName)
+			if _, invalid := loadCustomThemes(); invalid[themeName] != nil {
+				fmt.Printf("   %s: %v\n", getCustomThemePath(themeName), invalid[themeName])
+			}
 			fmt.Println("Use 'notetype theme list' to see available themes")
 			return
 		}
@@ -356,7 +737,7 @@ var themeSetCmd = &cobra.Command{
 			return
 		}
 
-		fmt.Printf("✅ Theme set to '%s'\n", themes[themeName].Name)
+		fmt.Printf("✅ Theme set to '%s'\n", theme.Name)
 		fmt.Println("💡 Restart the TUI to see the changes")
 	},
 }
@@ -371,9 +752,65 @@ var themePreviewCmd = &cobra.Command{
 	},
 }
 
+// themeCreateCmd creates a custom theme from an existing one
+var themeCreateCmd = &cobra.Command{
+	Use:   "create <theme-name>",
+	Short: "Create a custom theme based on an existing one",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		if !themeNameRe.MatchString(name) {
+			fmt.Println("❌ Theme names may only contain letters, digits, '-' and '_'")
+			os.Exit(1)
+		}
+
+		force, _ := cmd.Flags().GetBool("force")
+		if _, err := os.Stat(getCustomThemePath(name)); err == nil && !force {
+			fmt.Printf("❌ Theme '%s' already exists. Use --force to replace it\n", name)
+			os.Exit(1)
+		}
+
+		from, _ := cmd.Flags().GetString("from")
+		base, exists := findTheme(from)
+		if !exists {
+			fmt.Printf("❌ Theme '%s' not found\n", from)
+			os.Exit(1)
+		}
+
+		theme := base
+		theme.Name = name
+		if title, _ := cmd.Flags().GetString("title"); title != "" {
+			theme.Name = title
+		}
+
+		colors, _ := cmd.Flags().GetStringToString("color")
+		for field, value := range colors {
+			if err := setThemeColor(&theme, field, value); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		if err := saveCustomTheme(name, theme); err != nil {
+			fmt.Printf("❌ Invalid theme: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("✅ Created theme '%s' from '%s'\n", name, from)
+		fmt.Printf("📍 %s\n", getCustomThemePath(name))
+		fmt.Printf("💡 Edit the colors there, then use it with: notetype theme set %s\n", name)
+	},
+}
+
 func init() {
+	themeCreateCmd.Flags().String("from", "violet", "Theme to copy the colors from")
+	themeCreateCmd.Flags().String("title", "", "Display name of the theme")
+	themeCreateCmd.Flags().StringToString("color", nil, "Override colors (primary=#FF8800,accent=#00FFAA)")
+	themeCreateCmd.Flags().BoolP("force", "f", false, "Replace an existing custom theme")
+
 	themeCmd.AddCommand(themeListCmd)
 	themeCmd.AddCommand(themeSetCmd)
 	themeCmd.AddCommand(themePreviewCmd)
+	themeCmd.AddCommand(themeCreateCmd)
 	rootCmd.AddCommand(themeCmd)
 }
@@ -4,101 +4,19 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 )
 
-// Styles
-var (
-	// Color palette
-	primaryColor   = lipgloss.Color("#7C3AED")
-	secondaryColor = lipgloss.Color("#8B5CF6")
-	accentColor    = lipgloss.Color("#A78BFA")
-	successColor   = lipgloss.Color("#10B981")
-	warningColor   = lipgloss.Color("#F59E0B")
-	errorColor     = lipgloss.Color("#EF4444")
-	textColor      = lipgloss.Color("#E5E7EB")
-	mutedColor     = lipgloss.Color("#9CA3AF")
-	bgColor        = lipgloss.Color("#1F2937")
-	bgAltColor     = lipgloss.Color("#374151")
-
-	// Title bar style
-	titleStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(primaryColor).
-			Bold(true).
-			Padding(0, 2).
-			MarginBottom(1)
-
-	// Status bar style
-	statusBarStyle = lipgloss.NewStyle().
-			Foreground(textColor).
-			Background(bgAltColor).
-			Padding(0, 1)
-
-	statusStyle = lipgloss.NewStyle().
-			Foreground(mutedColor).
-			Italic(true)
-
-	// Help style
-	helpStyle = lipgloss.NewStyle().
-			Foreground(mutedColor).
-			MarginTop(1)
-
-	// Selected item style
-	selectedItemStyle = lipgloss.NewStyle().
-				Foreground(primaryColor).
-				Bold(true).
-				PaddingLeft(2)
-
-	// Normal item style
-	normalItemStyle = lipgloss.NewStyle().
-			Foreground(textColor).
-			PaddingLeft(2)
-
-	// Panel styles
-	panelStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(primaryColor).
-			Padding(1, 2).
-			MarginRight(2)
-
-	activePanelStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(accentColor).
-				Padding(1, 2).
-				MarginRight(2)
-
-	// Editor styles
-	editorStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(secondaryColor).
-			Padding(1)
-
-	// Button styles
-	activeButtonStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#FFFFFF")).
-				Background(primaryColor).
-				Bold(true).
-				Padding(0, 3).
-				MarginRight(2)
-
-	inactiveButtonStyle = lipgloss.NewStyle().
-				Foreground(mutedColor).
-				Background(bgAltColor).
-				Padding(0, 3).
-				MarginRight(2)
-)
-
 // View modes
 type viewMode int
 
@@ -111,6 +29,14 @@ const (
 	tagsView
 	templatesView
 	themesView
+	entriesView
+	journalPickerView
+	templateFormView
+	bulkTagView
+	renameView
+	filterView
+	tagPreviewView
+	confirmView
 )
 
 // Key bindings
@@ -128,6 +54,16 @@ type keyMap struct {
 	NewEntry key.Binding
 	Help     key.Binding
 	Edit     key.Binding
+	Mark     key.Binding
+	Tag      key.Binding
+	Untag    key.Binding
+	Rename   key.Binding
+	Move     key.Binding
+	Pin      key.Binding
+	Sort     key.Binding
+	Filter   key.Binding
+	Clear    key.Binding
+	Period   key.Binding
 }
 
 var keys = keyMap{
@@ -183,6 +119,46 @@ var keys = keyMap{
 		key.WithKeys("e"),
 		key.WithHelp("e", "edit"),
 	),
+	Mark: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "mark"),
+	),
+	Tag: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "add tag"),
+	),
+	Untag: key.NewBinding(
+		key.WithKeys("u"),
+		key.WithHelp("u", "remove tag"),
+	),
+	Rename: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "rename"),
+	),
+	Move: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "move to notebook"),
+	),
+	Pin: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "pin"),
+	),
+	Sort: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "sort"),
+	),
+	Filter: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "filter"),
+	),
+	Clear: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "clear filters"),
+	),
+	Period: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "related tags period"),
+	),
 }
 
 // Menu items
@@ -199,23 +175,53 @@ func (m menuItem) FilterValue() string { return m.title }
 // Note item
 type noteItem struct {
 	filename string
+	path     string
 	title    string
-	date     string
+	heading  string // title from front matter or the first H1
+	created  time.Time
+	modified time.Time
+	bytes    int64
 	size     string
+	tags     []string
+	marked   bool
+	depth    int // how deep the note sits in the notebook tree
 }
 
-func (n noteItem) Title() string       { return "📄 " + n.title }
-func (n noteItem) Description() string { return n.date + " • " + n.size }
+func (n noteItem) Title() string {
+	indent := strings.Repeat("  ", n.depth)
+	if n.marked {
+		return indent + "✓ 📄 " + n.title
+	}
+	return indent + "📄 " + n.title
+}
+func (n noteItem) Description() string {
+	return strings.Repeat("  ", n.depth) + "Created " + n.created.Format("Jan 2, 2006") +
+		" • Modified " + n.modified.Format("Jan 2, 2006 15:04") + " • " + n.size
+}
 func (n noteItem) FilterValue() string { return n.title }
 
 // Tag item
 type tagItem struct {
-	tag   string
-	count int
+	tag    string
+	count  int
+	marked bool
 }
 
-func (t tagItem) Title() string       { return "🏷️  #" + t.tag }
-func (t tagItem) Description() string { return fmt.Sprintf("%d entries", t.count) }
+// Nested tags are indented below their parent and show only their own name
+func (t tagItem) Title() string {
+	mark := ""
+	if t.marked {
+		mark = "✓ "
+	}
+	depth := strings.Count(t.tag, "/")
+	if depth == 0 {
+		return mark + "🏷️  #" + t.tag
+	}
+	return strings.Repeat("  ", depth) + mark + "└ #" + t.tag[strings.LastIndex(t.tag, "/")+1:]
+}
+func (t tagItem) Description() string {
+	return strings.Repeat("  ", strings.Count(t.tag, "/")) + fmt.Sprintf("%d entries", t.count)
+}
 func (t tagItem) FilterValue() string { return t.tag }
 
 // Template item
@@ -244,6 +250,50 @@ func (t themeItem) Title() string {
 func (t themeItem) Description() string { return "Press Enter to apply" }
 func (t themeItem) FilterValue() string { return t.name }
 
+// Journal entry item (a single timestamped block, or the whole day when index is 0)
+type entryItem struct {
+	date    string
+	index   int
+	time    string
+	preview string
+	tags    []string
+}
+
+func (e entryItem) Title() string {
+	if e.index == 0 {
+		return "📔 Whole day"
+	}
+	return "🕒 " + e.time + "  " + e.preview
+}
+func (e entryItem) Description() string {
+	if e.index == 0 {
+		return "View the full journal for " + e.date
+	}
+	if len(e.tags) > 0 {
+		return fmt.Sprintf("Entry %d • #%s", e.index, strings.Join(e.tags, " #"))
+	}
+	return fmt.Sprintf("Entry %d", e.index)
+}
+func (e entryItem) FilterValue() string {
+	return e.time + " " + e.preview + " " + strings.Join(e.tags, " ")
+}
+
+// Journal picker item
+type journalBookItem struct {
+	name    string
+	entries int
+	current bool
+}
+
+func (j journalBookItem) Title() string {
+	if j.current {
+		return "✓ 📓 " + j.name
+	}
+	return "  📓 " + j.name
+}
+func (j journalBookItem) Description() string { return fmt.Sprintf("%d entries", j.entries) }
+func (j journalBookItem) FilterValue() string { return j.name }
+
 // Model
 type model struct {
 	mode          viewMode
@@ -255,25 +305,58 @@ type model struct {
 	tagsList      list.Model
 	templatesList list.Model
 	themesList    list.Model
+	entriesList   list.Model
+	journalPicker list.Model
+	formTemplate  string
+	formPrompts   []string
+	formInputs    []textinput.Model
+	formFocus     int
+	templatePrev  string
+	themePreview  string
+	tagScan       []taggedFile
+	tagRelated    string
+	tagPeriod     string
+	tagPreview    tagPreview
+	confirm       confirmation
+	bulkInput     textinput.Model
+	bulkRemove    bool
+	bulkPaths     []string
+	renameInput   textinput.Model
+	renameFrom    string
+	folderMove    bool
+	openFolders   map[string]bool
+	noteFilter    listFilter
+	dayFilter     listFilter
+	filterInput   textinput.Model
+	completions   []completion
+	completeIdx   int
+	completeFrom  string
+	completeLink  bool
+	tagVocab      []string
+	noteVocab     []string
 	editor        textarea.Model
 	viewer        viewport.Model
 	statusMsg     string
 	currentNote   string
+	currentEntry  int
 	isJournal     bool
+	newFile       bool
 	showHelp      bool
 	selectedMenu  int
+	styles        Styles
 }
 
 func initialTUIModel() model {
-	// Load and apply theme
-	theme := loadTheme()
-	applyThemeToStyles(theme)
+	return newTUIModel(loadTheme())
+}
 
-	// Menu items
-	items := []list.Item{
+// mainMenuItems returns the fixed entries of the main menu
+func mainMenuItems() []list.Item {
+	return []list.Item{
 		menuItem{title: "Today's Journal", desc: "Write or view today's journal entry", icon: "📔"},
 		menuItem{title: "All Journals", desc: "Browse all your journal entries", icon: "📚"},
-		menuItem{title: "Notes", desc: "Manage your notes", icon: "📝"},
+		menuItem{title: "Switch Journal", desc: "Pick the journal to write in", icon: "📓"},
+		menuItem{title: "Notes", desc: "Browse your notes and notebooks", icon: "📝"},
 		menuItem{title: "New Note", desc: "Create a new note", icon: "✨"},
 		menuItem{title: "Templates", desc: "Create from template", icon: "📋"},
 		menuItem{title: "Tags", desc: "Browse notes by tags", icon: "🏷️"},
@@ -282,18 +365,25 @@ func initialTUIModel() model {
 		menuItem{title: "Export", desc: "Export to PDF/HTML", icon: "📤"},
 		menuItem{title: "Settings", desc: "Configure NoteType", icon: "⚙️"},
 	}
+}
+
+// newTUIModel builds the TUI drawn in a specific theme
+func newTUIModel(theme Theme) model {
+	styles := NewStyles(theme)
+
+	// Menu items, followed by pinned and recent notes
+	items := append(mainMenuItems(), quickItems()...)
 
-	menuList := list.New(items, list.NewDefaultDelegate(), 0, 0)
-	menuList.Title = "NoteType - Main Menu"
+	menuList := styles.newList(items, 0, 0, "NoteType - Main Menu")
 	menuList.SetShowStatusBar(false)
 	menuList.SetFilteringEnabled(false)
-	menuList.Styles.Title = titleStyle
 
 	// Initialize textarea for editor
 	ta := textarea.New()
 	ta.Placeholder = "Start writing your thoughts..."
 	ta.Focus()
 	ta.CharLimit = 0
+	styles.styleEditor(&ta)
 
 	// Initialize viewport for viewer
 	vp := viewport.New(0, 0)
@@ -305,9 +395,30 @@ func initialTUIModel() model {
 		viewer:       vp,
 		statusMsg:    "Welcome to NoteType! Press ? for help",
 		selectedMenu: 0,
+		styles:       styles,
 	}
 }
 
+// withTheme restyles every component of the TUI in another theme
+func (m model) withTheme(theme Theme) model {
+	m.styles = NewStyles(theme)
+
+	for _, l := range []*list.Model{
+		&m.menuList, &m.notesList, &m.journalsList, &m.tagsList, &m.templatesList,
+		&m.themesList, &m.entriesList, &m.journalPicker,
+	} {
+		m.styles.styleList(l)
+	}
+	m.styles.styleEditor(&m.editor)
+	for i := range m.formInputs {
+		m.styles.styleInput(&m.formInputs[i])
+	}
+	m.styles.styleInput(&m.bulkInput)
+	m.styles.styleInput(&m.renameInput)
+	m.styles.styleInput(&m.filterInput)
+	return m
+}
+
 func (m model) Init() tea.Cmd {
 	return textarea.Blink
 }
@@ -327,27 +438,72 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.editor.SetHeight(msg.Height - 12)
 		m.viewer.Width = msg.Width - 6
 		m.viewer.Height = msg.Height - 12
-
-		if m.mode == listView || m.mode == tagsView || m.mode == templatesView || m.mode == themesView {
-			m.notesList.SetSize(msg.Width-4, msg.Height-8)
-			m.journalsList.SetSize(msg.Width-4, msg.Height-8)
-			m.tagsList.SetSize(msg.Width-4, msg.Height-8)
-			m.templatesList.SetSize(msg.Width-4, msg.Height-8)
-			m.themesList.SetSize(msg.Width-4, msg.Height-8)
+		m.tagPreview.pane.Width = msg.Width - 6
+		m.tagPreview.pane.Height = msg.Height - 14
+
+		if m.mode == listView || m.mode == tagsView || m.mode == templatesView || m.mode == themesView || m.mode == entriesView || m.mode == journalPickerView {
+			m.notesList.SetSize(msg.Width-4, m.listHeight(m.noteFilter))
+			m.journalsList.SetSize(msg.Width-4, m.listHeight(m.dayFilter))
+			m.tagsList.SetSize((msg.Width-4)/2, msg.Height-8)
+			m.templatesList.SetSize((msg.Width-4)/2, msg.Height-8)
+			m.themesList.SetSize((msg.Width-4)/2, msg.Height-8)
+			m.entriesList.SetSize(msg.Width-4, msg.Height-8)
+			m.journalPicker.SetSize(msg.Width-4, msg.Height-8)
+		}
+		if m.mode == themesView {
+			if theme, ok := m.selectedTheme(); ok {
+				m.themePreview = m.renderThemePreview(theme)
+			}
 		}
 
 	case tea.KeyMsg:
+		// Plain letters are text while typing, so only Ctrl+C quits there
+		typing := m.mode == editorView || m.mode == templateFormView || m.mode == bulkTagView || m.mode == renameView || m.mode == filterView
+
 		// Global key bindings
 		switch {
-		case key.Matches(msg, keys.Quit):
+		case typing && msg.String() == "ctrl+c":
 			return m, tea.Quit
 
-		case key.Matches(msg, keys.Help):
+		case !typing && key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+
+		case !typing && key.Matches(msg, keys.Help):
 			m.showHelp = !m.showHelp
 			return m, nil
 
 		case key.Matches(msg, keys.Back):
+			if m.mode == editorView && len(m.completions) > 0 {
+				m.completions = nil
+				return m, nil
+			}
+			if m.mode == editorView {
+				m = m.resetCompletions()
+				m.newFile = false
+			}
+			if m.mode == bulkTagView || m.mode == renameView || m.mode == filterView {
+				m.mode = listView
+				m.statusMsg = "Cancelled"
+				return m, nil
+			}
+			if m.mode == confirmView {
+				m.mode = m.confirm.from
+				m.confirm = confirmation{}
+				m.statusMsg = "Cancelled, nothing was changed"
+				return m, nil
+			}
+			if m.mode == tagPreviewView {
+				m.mode = m.tagPreview.from
+				m.tagPreview = tagPreview{}
+				m.statusMsg = "Cancelled, nothing was changed"
+				return m, nil
+			}
+			if m.mode == themesView {
+				// Leaving without Enter drops the theme being previewed
+				m = m.withTheme(loadTheme())
+			}
 			if m.mode != menuView {
+				m = m.refreshMenu()
 				m.mode = menuView
 				m.statusMsg = "Returned to main menu"
 				return m, nil
@@ -363,22 +519,44 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if item, ok := selectedItem.(menuItem); ok {
 					return m.handleMenuSelection(item.title)
 				}
+				if item, ok := selectedItem.(quickItem); ok {
+					return m.openPath(item.path)
+				}
+			case len(msg.String()) == 1 && msg.String() >= "1" && msg.String() <= "9" && m.menuList.FilterState() != list.Filtering:
+				return m.openQuickItem(int(msg.String()[0] - '0'))
 			default:
+				from := m.menuList.Index()
 				m.menuList, cmd = m.menuList.Update(msg)
 				cmds = append(cmds, cmd)
+				m = m.skipMenuHeader(from)
 			}
 
 		case editorView:
 			switch {
 			case key.Matches(msg, keys.Save):
 				return m.saveCurrentNote()
+			case len(m.completions) > 0 && msg.String() == "tab":
+				m = m.acceptCompletion()
+			case len(m.completions) > 0 && msg.String() == "ctrl+n":
+				m.completeIdx = (m.completeIdx + 1) % len(m.completions)
+			case len(m.completions) > 0 && msg.String() == "ctrl+p":
+				m.completeIdx = (m.completeIdx + len(m.completions) - 1) % len(m.completions)
 			default:
 				m.editor, cmd = m.editor.Update(msg)
 				cmds = append(cmds, cmd)
+				m = m.updateCompletions()
 			}
 
 		case listView:
+			folder, onFolder := m.notesList.SelectedItem().(folderItem)
+			browsing := !m.isJournal && m.notesList.FilterState() != list.Filtering
 			switch {
+			case browsing && onFolder && (key.Matches(msg, keys.Enter) || key.Matches(msg, keys.Right)):
+				return m.setFolderOpen(folder.path, !folder.expanded || key.Matches(msg, keys.Right))
+			case browsing && key.Matches(msg, keys.Left):
+				return m.collapseFolder()
+			case browsing && key.Matches(msg, keys.Move):
+				return m.startMoveToFolder()
 			case key.Matches(msg, keys.Enter):
 				if m.isJournal {
 					selectedItem := m.journalsList.SelectedItem()
@@ -395,6 +573,23 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m.createNewEntry()
 			case key.Matches(msg, keys.Delete):
 				return m.deleteSelected()
+			case key.Matches(msg, keys.Mark) && m.currentList().FilterState() != list.Filtering:
+				markListItem(m.currentList())
+				m.statusMsg = fmt.Sprintf("%d marked - t to tag, u to untag, d to delete", len(markedNoteItems(*m.currentList())))
+			case key.Matches(msg, keys.Tag) && m.currentList().FilterState() != list.Filtering:
+				return m.startBulkTag(false)
+			case key.Matches(msg, keys.Untag) && m.currentList().FilterState() != list.Filtering:
+				return m.startBulkTag(true)
+			case key.Matches(msg, keys.Rename) && m.currentList().FilterState() != list.Filtering:
+				return m.startRename()
+			case key.Matches(msg, keys.Pin) && m.currentList().FilterState() != list.Filtering:
+				return m.togglePin()
+			case key.Matches(msg, keys.Sort) && m.currentList().FilterState() != list.Filtering:
+				return m.cycleSort()
+			case key.Matches(msg, keys.Filter) && m.currentList().FilterState() != list.Filtering:
+				return m.startFilter()
+			case key.Matches(msg, keys.Clear) && m.currentList().FilterState() != list.Filtering:
+				return m.clearFilter()
 			default:
 				if m.isJournal {
 					m.journalsList, cmd = m.journalsList.Update(msg)
@@ -420,9 +615,19 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if item, ok := selectedItem.(tagItem); ok {
 					return m.showEntriesWithTag(item.tag)
 				}
+			case key.Matches(msg, keys.Mark) && m.tagsList.FilterState() != list.Filtering:
+				markListItem(&m.tagsList)
+				m.tagRelated = m.renderRelatedTags()
+			case key.Matches(msg, keys.Delete) && m.tagsList.FilterState() != list.Filtering:
+				return m.removeMarkedTags()
+			case key.Matches(msg, keys.Period) && m.tagsList.FilterState() != list.Filtering:
+				m.tagPeriod = nextTagPeriod(m.tagPeriod)
+				m.tagRelated = m.renderRelatedTags()
+				m.statusMsg = "Related tags counted for " + m.tagPeriod
 			default:
 				m.tagsList, cmd = m.tagsList.Update(msg)
 				cmds = append(cmds, cmd)
+				m.tagRelated = m.renderRelatedTags()
 			}
 
 		case templatesView:
@@ -430,11 +635,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case key.Matches(msg, keys.Enter):
 				selectedItem := m.templatesList.SelectedItem()
 				if item, ok := selectedItem.(templateItem); ok {
-					return m.createFromTemplate(item.name)
+					return m.startTemplateForm(item.name)
 				}
 			default:
 				m.templatesList, cmd = m.templatesList.Update(msg)
 				cmds = append(cmds, cmd)
+				m.templatePrev = m.renderTemplatePreview()
 			}
 
 		case themesView:
@@ -445,8 +651,120 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m.applyTheme(item.name)
 				}
 			default:
+				previous := m.themesList.Index()
 				m.themesList, cmd = m.themesList.Update(msg)
 				cmds = append(cmds, cmd)
+				if m.themesList.Index() != previous {
+					m = m.previewSelectedTheme()
+				}
+			}
+
+		case entriesView:
+			switch {
+			case key.Matches(msg, keys.Enter):
+				if item, ok := m.entriesList.SelectedItem().(entryItem); ok {
+					return m.openJournalEntry(item.date, item.index)
+				}
+			case key.Matches(msg, keys.Edit) && m.entriesList.FilterState() != list.Filtering:
+				if item, ok := m.entriesList.SelectedItem().(entryItem); ok {
+					m.currentNote = item.date
+					m.currentEntry = item.index
+					m.isJournal = true
+					return m.editCurrentNote()
+				}
+			case key.Matches(msg, keys.Delete) && m.entriesList.FilterState() != list.Filtering:
+				return m.confirmDeleteEntry()
+			default:
+				m.entriesList, cmd = m.entriesList.Update(msg)
+				cmds = append(cmds, cmd)
+			}
+
+		case templateFormView:
+			switch msg.String() {
+			case "tab", "down":
+				return m.focusFormInput(m.formFocus + 1)
+			case "shift+tab", "up":
+				return m.focusFormInput(m.formFocus - 1)
+			case "enter":
+				if m.formFocus < len(m.formInputs)-1 {
+					return m.focusFormInput(m.formFocus + 1)
+				}
+				// The first field is always the note title
+				title := strings.TrimSpace(m.formInputs[0].Value())
+				answers := make(map[string]string)
+				for i, name := range m.formPrompts[1:] {
+					answers[name] = strings.TrimSpace(m.formInputs[i+1].Value())
+				}
+				return m.createFromTemplate(m.formTemplate, title, answers)
+			default:
+				m.formInputs[m.formFocus], cmd = m.formInputs[m.formFocus].Update(msg)
+				cmds = append(cmds, cmd)
+			}
+
+		case bulkTagView:
+			switch msg.String() {
+			case "enter":
+				return m.applyBulkTag(m.bulkInput.Value())
+			default:
+				m.bulkInput, cmd = m.bulkInput.Update(msg)
+				cmds = append(cmds, cmd)
+			}
+
+		case confirmView:
+			switch msg.String() {
+			case "enter", "y":
+				yes := m.confirm.yes
+				m.mode = m.confirm.from
+				m.confirm = confirmation{}
+				return yes(m)
+			case "n":
+				m.mode = m.confirm.from
+				m.confirm = confirmation{}
+				m.statusMsg = "Cancelled, nothing was changed"
+			}
+
+		case tagPreviewView:
+			switch msg.String() {
+			case "enter", "y":
+				return m.confirmTagEdits()
+			case "n":
+				m.mode = m.tagPreview.from
+				m.tagPreview = tagPreview{}
+				m.statusMsg = "Cancelled, nothing was changed"
+			default:
+				m.tagPreview.pane, cmd = m.tagPreview.pane.Update(msg)
+				cmds = append(cmds, cmd)
+			}
+
+		case renameView:
+			switch msg.String() {
+			case "enter":
+				return m.applyRename(false)
+			case "ctrl+t":
+				return m.applyRename(true)
+			default:
+				m.renameInput, cmd = m.renameInput.Update(msg)
+				cmds = append(cmds, cmd)
+			}
+
+		case filterView:
+			switch msg.String() {
+			case "enter":
+				return m.applyFilter(m.filterInput.Value())
+			default:
+				m.filterInput, cmd = m.filterInput.Update(msg)
+				cmds = append(cmds, cmd)
+			}
+
+		case journalPickerView:
+			switch {
+			case key.Matches(msg, keys.Enter):
+				if item, ok := m.journalPicker.SelectedItem().(journalBookItem); ok {
+					return m.switchJournal(item.name)
+				}
+			default:
+				m.journalPicker, cmd = m.journalPicker.Update(msg)
+				cmds = append(cmds, cmd)
 			}
 		}
 	}
@@ -462,7 +780,7 @@ func (m model) View() string {
 	var content string
 
 	// Title bar
-	title := titleStyle.Width(m.width).Render("✨ NoteType - Your Personal Journal & Notes")
+	title := m.styles.Title.Width(m.width).Render("✨ NoteType - Your Personal Journal & Notes")
 
 	// Main content based on mode
 	switch m.mode {
@@ -477,11 +795,27 @@ func (m model) View() string {
 	case searchView:
 		content = "Search view (coming soon)"
 	case tagsView:
-		content = m.tagsList.View()
+		content = m.renderTags()
 	case templatesView:
-		content = m.templatesList.View()
+		content = m.renderTemplates()
 	case themesView:
-		content = m.themesList.View()
+		content = m.renderThemes()
+	case entriesView:
+		content = m.entriesList.View()
+	case journalPickerView:
+		content = m.journalPicker.View()
+	case templateFormView:
+		content = m.renderTemplateForm()
+	case bulkTagView:
+		content = m.renderBulkTag()
+	case tagPreviewView:
+		content = m.renderTagPreview()
+	case confirmView:
+		content = m.renderConfirm()
+	case renameView:
+		content = m.renderRename()
+	case filterView:
+		content = m.renderFilter()
 	}
 
 	// Status bar
@@ -502,26 +836,29 @@ func (m model) View() string {
 
 func (m model) renderEditor() string {
 	headerText := "📝 Writing"
-	if m.isJournal {
-		headerText = "📔 Today's Journal - " + time.Now().Format("Monday, January 2, 2006")
+	if m.isJournal && m.currentEntry > 0 {
+		headerText = fmt.Sprintf("📔 Journal %s - Entry %d", m.currentNote, m.currentEntry)
+	} else if m.isJournal {
+		headerText = "📔 Today's " + journalTitle(activeJournal) + " - " + time.Now().Format("Monday, January 2, 2006")
 	} else if m.currentNote != "" {
 		headerText = "📄 Editing: " + m.currentNote
 	}
 
-	header := lipgloss.NewStyle().
-		Foreground(accentColor).
-		Bold(true).
-		MarginBottom(1).
-		Render(headerText)
+	header := m.styles.Header.Render(headerText)
 
-	editorBox := editorStyle.Width(m.width - 4).Render(m.editor.View())
+	editorBox := m.styles.Editor.Width(m.width - 4).Render(m.editor.View())
 
 	buttons := lipgloss.JoinHorizontal(
 		lipgloss.Left,
-		activeButtonStyle.Render("💾 Save (Ctrl+S)"),
-		inactiveButtonStyle.Render("❌ Cancel (Esc)"),
+		m.styles.ActiveButton.Render("💾 Save (Ctrl+S)"),
+		m.styles.InactiveButton.Render("❌ Cancel (Esc)"),
 	)
 
+	// Suggestions take the place of the buttons while they are open
+	if len(m.completions) > 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, header, editorBox, "", m.renderCompletions())
+	}
+
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		header,
@@ -532,20 +869,20 @@ func (m model) renderEditor() string {
 }
 
 func (m model) renderList() string {
+	l, f := m.notesList, m.noteFilter
 	if m.isJournal {
-		return m.journalsList.View()
+		l, f = m.journalsList, m.dayFilter
+	}
+	if f.isEmpty() {
+		return l.View()
 	}
-	return m.notesList.View()
+	return lipgloss.JoinVertical(lipgloss.Left, m.renderFilterChips(f), l.View())
 }
 
 func (m model) renderViewer() string {
-	header := lipgloss.NewStyle().
-		Foreground(accentColor).
-		Bold(true).
-		MarginBottom(1).
-		Render("👁️  Viewing: " + m.currentNote + " (Press 'e' to edit)")
+	header := m.styles.Header.Render("👁️  Viewing: " + m.currentNote + " (Press 'e' to edit)")
 
-	viewerBox := panelStyle.Width(m.width - 4).Render(m.viewer.View())
+	viewerBox := m.styles.Viewer.Width(m.width - 4).Render(m.viewer.View())
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -574,20 +911,29 @@ func (m model) renderStatusBar() string {
 		modeStr = "📋 Templates"
 	case themesView:
 		modeStr = "🎨 Themes"
+	case entriesView:
+		modeStr = "🕒 Entries"
+	case journalPickerView:
+		modeStr = "📓 Journals"
+	case templateFormView:
+		modeStr = "📋 Template"
+	case bulkTagView, tagPreviewView:
+		modeStr = "🏷️  Tag"
+	case confirmView:
+		modeStr = "❓ Confirm"
+	case renameView:
+		modeStr = "✏️  Rename"
+		if m.folderMove {
+			modeStr = "📁 Move"
+		}
+	case filterView:
+		modeStr = "🔎 Filter"
 	}
 
-	left := lipgloss.NewStyle().
-		Foreground(accentColor).
-		Bold(true).
-		Render(modeStr+" • ") +
-		lipgloss.NewStyle().
-			Foreground(mutedColor).
-			Render(m.statusMsg)
+	left := m.styles.StatusMode.Render(modeStr+" • ") + m.styles.MutedText.Render(m.statusMsg)
 
 	// Right side - time
-	right := lipgloss.NewStyle().
-		Foreground(mutedColor).
-		Render(time.Now().Format("15:04"))
+	right := m.styles.MutedText.Render(time.Now().Format("15:04"))
 
 	// Create status bar
 	gap := m.width - lipgloss.Width(left) - lipgloss.Width(right) - 4
@@ -595,14 +941,14 @@ func (m model) renderStatusBar() string {
 		gap = 0
 	}
 
-	return statusBarStyle.
+	return m.styles.StatusBar.
 		Width(m.width).
 		Render(left + strings.Repeat(" ", gap) + right)
 }
 
 func (m model) renderHelp() string {
 	if !m.showHelp {
-		return helpStyle.Render("Press ? for help")
+		return m.styles.Help.Render("Press ? for help")
 	}
 
 	helpText := `
@@ -614,25 +960,33 @@ func (m model) renderHelp() string {
                  q / Ctrl+C    Quit
   
   Actions:       n             New entry (in lists)
-                 d             Delete (in lists)
-                 e             Edit (in viewer)
+                 d             Delete (in lists, marked items first)
+                 space         Mark notes or tags for bulk actions
+                 t / u         Add / remove a tag on marked notes
+                 r             Rename or move (in lists)
+                 ←/→ m         Collapse / expand notebooks, move notes to one
+                 p             Pin / unpin (in lists)
+                 s             Sort by name, title, created, modified or size
+                 f / x         Filter by tag, notebook or dates / clear filters
+                 1-9           Open a pinned or recent note (in menu)
+                 e             Edit (in viewer and entries)
                  /             Search
                  Ctrl+S        Save (in editor)
+                 # / [[        Suggest tags / note links (Tab accepts)
                  ?             Toggle help
   
   TUI Features:
-  • Tags: Select from menu to browse all tags
+  • Journals: Open a day to pick a single timestamped entry
+  • Tags: Select from menu to browse all tags; d previews and strips marked tags,
+    p switches related tags between this week, month, year or all time
   • Templates: Select to create from template
   • Themes: Select to change colors instantly
   
   Press ? again to hide help
   `
 
-	return helpStyle.
-		Width(m.width-4).
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(mutedColor).
-		Padding(1, 2).
+	return m.styles.HelpBox.
+		Width(m.width - 4).
 		Render(helpText)
 }
 
@@ -657,6 +1011,8 @@ func (m model) handleMenuSelection(title string) (tea.Model, tea.Cmd) {
 		return m.openTodayJournal()
 	case "All Journals":
 		return m.loadJournals()
+	case "Switch Journal":
+		return m.loadJournalPicker()
 	case "Notes":
 		return m.loadNotes()
 	case "New Note":
@@ -683,51 +1039,87 @@ func (m model) handleMenuSelection(title string) (tea.Model, tea.Cmd) {
 
 // Load tags view
 func (m model) loadTags() (tea.Model, tea.Cmd) {
-	tagCounts, err := getAllTags()
-	if err != nil {
-		m.statusMsg = "Error loading tags: " + err.Error()
-		return m, nil
-	}
+	m.tagScan = scanTaggedFiles()
+	tagCounts := tagTreeCounts(m.tagScan)
 
 	if len(tagCounts) == 0 {
 		m.statusMsg = "No tags found. Add #tags to your notes!"
 		return m, nil
 	}
 
-	// Sort by count
-	type tagCount struct {
-		tag   string
-		count int
-	}
-	var tags []tagCount
-	for tag, count := range tagCounts {
-		tags = append(tags, tagCount{tag, count})
+	// Show tags as a tree, each parent followed by its nested tags
+	var tags []string
+	for tag := range tagCounts {
+		tags = append(tags, tag)
 	}
-	sort.Slice(tags, func(i, j int) bool {
-		if tags[i].count == tags[j].count {
-			return tags[i].tag < tags[j].tag
-		}
-		return tags[i].count > tags[j].count
-	})
+	tagTreeOrder(tags)
 
 	// Create list items
 	var items []list.Item
-	for _, tc := range tags {
+	for _, tag := range tags {
 		items = append(items, tagItem{
-			tag:   tc.tag,
-			count: tc.count,
+			tag:   tag,
+			count: tagCounts[tag],
 		})
 	}
 
-	m.tagsList = list.New(items, list.NewDefaultDelegate(), m.width-4, m.height-8)
-	m.tagsList.Title = "🏷️  All Tags - Press Enter to filter"
-	m.tagsList.Styles.Title = titleStyle
+	m.tagsList = m.styles.newList(items, (m.width-4)/2, m.height-8, "🏷️  All Tags - Press Enter to filter")
+	m.tagRelated = m.renderRelatedTags()
 	m.mode = tagsView
 	m.statusMsg = fmt.Sprintf("Found %d tags", len(items))
 
 	return m, nil
 }
 
+// Show the tags that appear together with the selected tag
+func (m model) renderRelatedTags() string {
+	item, ok := m.tagsList.SelectedItem().(tagItem)
+	if !ok {
+		return ""
+	}
+
+	period := m.tagPeriod
+	if period == "" {
+		period = tagPeriods[1]
+	}
+	since, label, _ := tagPeriodStart(period, time.Now())
+	related, matches := relatedTags(m.tagScan, item.tag, since)
+
+	lines := []string{
+		m.styles.Header.Render("🔗 Related to #" + item.tag),
+		m.styles.MutedText.Render(fmt.Sprintf("%d entries • period: %s (p to change)", matches, period)),
+		"",
+	}
+	if len(related) == 0 {
+		lines = append(lines, m.styles.MutedText.Render("Never appears with another tag"))
+	}
+	for _, r := range related {
+		lines = append(lines, m.styles.NormalItem.Render("#"+r.tag)+"  "+m.styles.MutedText.Render(formatRelatedTag(r, label)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Render the tags list next to the related tags of the selected one
+func (m model) renderTags() string {
+	listWidth := (m.width - 4) / 2
+	paneHeight := m.height - 12
+	if paneHeight < 1 {
+		paneHeight = 1
+	}
+
+	lines := strings.Split(m.tagRelated, "\n")
+	if len(lines) > paneHeight {
+		lines = append(lines[:paneHeight-1], "…")
+	}
+
+	pane := m.styles.Panel.
+		Width(m.width - listWidth - 8).
+		Height(paneHeight).
+		Render(strings.Join(lines, "\n"))
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, m.tagsList.View(), pane)
+}
+
 // Show entries with specific tag
 func (m model) showEntriesWithTag(tag string) (tea.Model, tea.Cmd) {
 	files, err := findFilesByTag(tag)
@@ -743,21 +1135,19 @@ func (m model) showEntriesWithTag(tag string) (tea.Model, tea.Cmd) {
 
 	// Create list items
 	var items []list.Item
+	journals := loadJournalConfigs()
 	for _, file := range files {
-		info, _ := os.Stat(file)
-		base := filepath.Base(file)
-		name := strings.TrimSuffix(base, ".md")
-		items = append(items, noteItem{
-			filename: name,
-			title:    name,
-			date:     info.ModTime().Format("Jan 2, 2006 15:04"),
-			size:     formatSizeInTUI(info.Size()),
-		})
+		name := strings.TrimSuffix(filepath.Base(file), ".md")
+		filename := name
+		if _, ok := journals.journalFor(file); !ok {
+			filename = noteLinkName(journals, file)
+		}
+		if item, err := newNoteItem(journals, file, filename, name); err == nil {
+			items = append(items, item)
+		}
 	}
 
-	m.notesList = list.New(items, list.NewDefaultDelegate(), m.width-4, m.height-8)
-	m.notesList.Title = fmt.Sprintf("📄 Entries tagged with #%s", tag)
-	m.notesList.Styles.Title = titleStyle
+	m.notesList = m.styles.newList(items, m.width-4, m.height-8, fmt.Sprintf("📄 Entries tagged with #%s", tag))
 	m.mode = listView
 	m.isJournal = false
 	m.statusMsg = fmt.Sprintf("Found %d entries with #%s", len(items), tag)
@@ -767,86 +1157,247 @@ func (m model) showEntriesWithTag(tag string) (tea.Model, tea.Cmd) {
 
 // Load templates view
 func (m model) loadTemplates() (tea.Model, tea.Cmd) {
-	templates := []string{"daily", "meeting", "project", "weekly", "idea", "grateful"}
-
 	var items []list.Item
-	for _, name := range templates {
+	for _, name := range availableTemplates() {
 		items = append(items, templateItem{
 			name: name,
-			desc: getTemplateDescription(name),
+			desc: templateDescription(name, getTemplateMeta(name)),
 		})
 	}
 
-	m.templatesList = list.New(items, list.NewDefaultDelegate(), m.width-4, m.height-8)
-	m.templatesList.Title = "📋 Templates - Press Enter to use"
-	m.templatesList.Styles.Title = titleStyle
+	m.templatesList = m.styles.newList(items, (m.width-4)/2, m.height-8, "📋 Templates - Press Enter to use")
 	m.mode = templatesView
+	m.templatePrev = m.renderTemplatePreview()
 	m.statusMsg = fmt.Sprintf("%d templates available", len(items))
 
 	return m, nil
 }
 
-// Create from template
-func (m model) createFromTemplate(templateName string) (tea.Model, tea.Cmd) {
-	// Get template content
-	templateContent, exists := builtInTemplates[templateName]
-	if !exists {
-		m.statusMsg = "Template not found"
+// renderTemplatePreview renders the highlighted template, showing prompts as ‹placeholders›
+func (m model) renderTemplatePreview() string {
+	item, ok := m.templatesList.SelectedItem().(templateItem)
+	if !ok {
+		return ""
+	}
+
+	content, err := getTemplateContent(item.name)
+	if err != nil {
+		return "❌ " + err.Error()
+	}
+
+	prompts, err := templatePrompts(content)
+	if err != nil {
+		return "❌ " + err.Error()
+	}
+	answers := make(map[string]string)
+	for _, name := range prompts {
+		answers[name] = "‹" + name + "›"
+	}
+
+	preview, err := renderTemplate(content, templateContext{Now: time.Now(), Title: "New Entry", Answers: answers})
+	if err != nil {
+		return "❌ " + err.Error()
+	}
+	return preview
+}
+
+// renderTemplates shows the template list next to a live preview of the highlighted template
+func (m model) renderTemplates() string {
+	listWidth := (m.width - 4) / 2
+	previewHeight := m.height - 12
+	if previewHeight < 1 {
+		previewHeight = 1
+	}
+
+	lines := strings.Split(m.templatePrev, "\n")
+	if len(lines) > previewHeight {
+		lines = append(lines[:previewHeight-1], "…")
+	}
+
+	preview := m.styles.Panel.
+		Width(m.width - listWidth - 8).
+		Height(previewHeight).
+		Render(strings.Join(lines, "\n"))
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, m.templatesList.View(), preview)
+}
+
+// Ask for the note title and a template's {{prompt}} placeholders before creating a note from it
+func (m model) startTemplateForm(templateName string) (tea.Model, tea.Cmd) {
+	templateContent, err := getTemplateContent(templateName)
+	if err != nil {
+		m.statusMsg = "Error: " + err.Error()
 		return m, nil
 	}
 
-	// Prepare variables
-	now := time.Now()
-	vars := map[string]string{
-		"date":     now.Format("2006-01-02"),
-		"datetime": now.Format("2006-01-02 15:04"),
-		"time":     now.Format("15:04"),
-		"title":    "New Entry",
-		"year":     now.Format("2006"),
-		"month":    now.Format("January"),
-		"day":      now.Format("Monday"),
+	prompts, err := templatePrompts(templateContent)
+	if err != nil {
+		m.statusMsg = "Error: " + err.Error()
+		return m, nil
+	}
+	m.formTemplate = templateName
+	m.formPrompts = append([]string{"Title"}, prompts...)
+	m.formInputs = make([]textinput.Model, len(m.formPrompts))
+	for i, name := range m.formPrompts {
+		input := textinput.New()
+		input.Prompt = "› "
+		input.Placeholder = name
+		input.Width = m.width - 10
+		m.styles.styleInput(&input)
+		m.formInputs[i] = input
 	}
 
-	// Substitute variables
-	finalContent := substituteVariables(templateContent, vars)
+	m.mode = templateFormView
+	m.statusMsg = "Fill in the template - Tab to move, Enter on the last field to continue"
+	return m.focusFormInput(0)
+}
 
-	// Switch to editor with template content
-	m.mode = editorView
-	m.isJournal = false
-	m.currentNote = fmt.Sprintf("%s-%d", templateName, time.Now().Unix())
-	m.editor.SetValue(finalContent)
-	m.statusMsg = fmt.Sprintf("Using %s template - Edit and save with Ctrl+S", templateName)
+// Move the focus between the fields of the template form
+func (m model) focusFormInput(index int) (tea.Model, tea.Cmd) {
+	if index < 0 {
+		index = len(m.formInputs) - 1
+	}
+	if index >= len(m.formInputs) {
+		index = 0
+	}
+
+	m.formFocus = index
+	for i := range m.formInputs {
+		m.formInputs[i].Blur()
+	}
+	return m, m.formInputs[index].Focus()
+}
+
+func (m model) renderTemplateForm() string {
+	header := m.styles.Header.Render("📋 New note from '" + m.formTemplate + "'")
+
+	var fields []string
+	for i, name := range m.formPrompts {
+		label := m.styles.NormalItem.Render(name)
+		if i == m.formFocus {
+			label = m.styles.SelectedItem.Render(name)
+		}
+		fields = append(fields, label, "  "+m.formInputs[i].View(), "")
+	}
+
+	form := m.styles.Dialog.Width(m.width - 4).Render(lipgloss.JoinVertical(lipgloss.Left, fields...))
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		header,
+		form,
+		m.styles.ActiveButton.Render("↵ Continue (Enter)"),
+	)
+}
+
+// Create from template, the same way 'notetype template' does, then open the note
+func (m model) createFromTemplate(templateName, title string, answers map[string]string) (tea.Model, tea.Cmd) {
+	if title == "" {
+		title = "New Entry"
+	}
 
+	// The note is written on Ctrl+S, so Esc leaves nothing behind
+	filename, content, err := planTemplateNote(templateName, "", templateContext{
+		Now:     time.Now(),
+		Title:   title,
+		Answers: answers,
+	}, true)
+	if err != nil {
+		m.statusMsg = "Error: " + err.Error()
+		return m, nil
+	}
+
+	m.isJournal = false
+	m.currentNote = filename
+	m.currentEntry = 0
+	m.newFile = true
+	m.mode = editorView
+	m.editor.SetValue(content)
+	m.statusMsg = fmt.Sprintf("New %s.md from %s template - Ctrl+S to create it, Esc to discard", filename, templateName)
 	return m, textarea.Blink
 }
 
 // Load themes view
 func (m model) loadThemes() (tea.Model, tea.Cmd) {
-	currentTheme := loadTheme()
-	themeNames := []string{"violet", "dracula", "nord", "gruvbox", "solarized", "monokai", "tokyo", "catppuccin"}
+	current := currentThemeName()
+	all := allThemes()
+
+	// The auto item keeps the pair already chosen with 'theme set auto:<theme>'
+	autoItem := themeItem{name: autoTheme, display: "Auto (follows terminal background)"}
+	if isAutoTheme(current) {
+		autoItem.name = current
+		autoItem.current = true
+	}
 
-	var items []list.Item
-	for _, name := range themeNames {
-		theme := themes[name]
+	items := []list.Item{autoItem}
+	for _, name := range themeNames() {
 		items = append(items, themeItem{
 			name:    name,
-			display: theme.Name,
-			current: theme.Name == currentTheme.Name,
+			display: all[name].Name,
+			current: name == current,
 		})
 	}
 
-	m.themesList = list.New(items, list.NewDefaultDelegate(), m.width-4, m.height-8)
-	m.themesList.Title = "🎨 Themes - Press Enter to apply"
-	m.themesList.Styles.Title = titleStyle
+	m.themesList = m.styles.newList(items, (m.width-4)/2, m.height-8, "🎨 Themes - Press Enter to apply")
+	for i, item := range items {
+		if item.(themeItem).current {
+			m.themesList.Select(i)
+		}
+	}
 	m.mode = themesView
-	m.statusMsg = "Select a theme and press Enter"
+	m.themePreview = ""
+	if theme, ok := m.selectedTheme(); ok {
+		m.themePreview = m.renderThemePreview(theme)
+	}
+	m.statusMsg = "Move to preview a theme, Enter to apply, Esc to keep the current one"
 
 	return m, nil
 }
 
+// previewSelectedTheme restyles the whole TUI in the highlighted theme
+func (m model) previewSelectedTheme() model {
+	theme, ok := m.selectedTheme()
+	if !ok {
+		m.themePreview = ""
+		return m
+	}
+
+	m = m.withTheme(theme)
+	m.themePreview = m.renderThemePreview(theme)
+	m.statusMsg = fmt.Sprintf("Previewing %s - Enter to apply, Esc to cancel", theme.Name)
+	return m
+}
+
+// Look up the highlighted theme
+func (m model) selectedTheme() (Theme, bool) {
+	item, ok := m.themesList.SelectedItem().(themeItem)
+	if !ok {
+		return Theme{}, false
+	}
+	return findTheme(item.name)
+}
+
+// Render the swatches and a mock of a theme. It is done when the highlighted
+// theme or the window changes, not on every frame.
+func (m model) renderThemePreview(theme Theme) string {
+	previewWidth := m.width - (m.width-4)/2 - 8
+	mock := renderThemeMock(theme, previewWidth-4)
+	return m.styles.Panel.
+		Width(previewWidth).
+		Render(lipgloss.JoinVertical(lipgloss.Left, renderThemeSwatches(theme), "", mock))
+}
+
+// renderThemes shows the theme list next to the preview of the highlighted theme
+func (m model) renderThemes() string {
+	if m.themePreview == "" {
+		return m.themesList.View()
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, m.themesList.View(), m.themePreview)
+}
+
 // Apply theme
 func (m model) applyTheme(themeName string) (tea.Model, tea.Cmd) {
-	theme, exists := themes[themeName]
+	theme, exists := findTheme(themeName)
 	if !exists {
 		m.statusMsg = "Theme not found"
 		return m, nil
@@ -859,7 +1410,7 @@ func (m model) applyTheme(themeName string) (tea.Model, tea.Cmd) {
 	}
 
 	// Apply theme
-	applyThemeToStyles(theme)
+	m = m.withTheme(theme)
 
 	// Reload menu with new styles
 	m.statusMsg = fmt.Sprintf("✅ Applied theme: %s", theme.Name)
@@ -874,6 +1425,7 @@ func (m model) openTodayJournal() (tea.Model, tea.Cmd) {
 	m.mode = editorView
 	m.isJournal = true
 	m.currentNote = time.Now().Format("2006-01-02")
+	m.currentEntry = 0
 	m.statusMsg = "Writing today's journal"
 
 	// Load existing content if available
@@ -882,10 +1434,18 @@ func (m model) openTodayJournal() (tea.Model, tea.Cmd) {
 
 	if content, err := os.ReadFile(filepath); err == nil {
 		m.editor.SetValue(string(content))
-	} else {
-		m.editor.SetValue("")
+		return m, textarea.Blink
 	}
 
+	// A new day starts from the journal's template
+	now := time.Now()
+	structure, err := newJournalContent(now, promptForDay(loadReminderConfig(), now))
+	if err != nil {
+		m.statusMsg = "Error applying journal template: " + err.Error()
+		structure = ""
+	}
+	m.editor.SetValue(structure + "### " + now.Format("15:04") + "\n\n")
+
 	return m, textarea.Blink
 }
 
@@ -897,53 +1457,100 @@ func (m model) loadJournals() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	var items []list.Item
+	var days []noteItem
+	journals := loadJournalConfigs()
 	for _, file := range files {
-		info, _ := os.Stat(file)
 		name := strings.TrimSuffix(filepath.Base(file), ".md")
-		items = append(items, noteItem{
-			filename: name,
-			title:    name,
-			date:     info.ModTime().Format("Jan 2, 2006 15:04"),
-			size:     formatSizeInTUI(info.Size()),
-		})
+		if item, err := newNoteItem(journals, file, name, name); err == nil && m.dayFilter.matches(item) {
+			days = append(days, item)
+		}
+	}
+	mode := listSortMode("journals")
+	sortNoteItems(days, mode)
+
+	var items []list.Item
+	for _, day := range days {
+		items = append(items, day)
 	}
 
-	m.journalsList = list.New(items, list.NewDefaultDelegate(), m.width-4, m.height-8)
-	m.journalsList.Title = "📚 Journal Entries"
-	m.journalsList.Styles.Title = titleStyle
+	title := "📚 " + journalTitle(activeJournal) + " Entries · by " + mode
+	m.journalsList = m.styles.newList(items, m.width-4, m.listHeight(m.dayFilter), title)
 	m.mode = listView
 	m.isJournal = true
 	m.statusMsg = fmt.Sprintf("Found %d journal entries", len(items))
+	if !m.dayFilter.isEmpty() {
+		m.statusMsg = fmt.Sprintf("%d of %d journal entries match", len(items), len(files))
+	}
 
 	return m, nil
 }
 
-func (m model) loadNotes() (tea.Model, tea.Cmd) {
-	files, err := filepath.Glob("*.md")
-	if err != nil {
-		m.statusMsg = "Error loading notes: " + err.Error()
+// Load the journal picker
+func (m model) loadJournalPicker() (tea.Model, tea.Cmd) {
+	var items []list.Item
+	journals := loadJournalConfigs()
+	for _, name := range journals.names() {
+		files, _ := filepath.Glob(filepath.Join(journals.dir(name), "*.md"))
+		items = append(items, journalBookItem{
+			name:    name,
+			entries: len(files),
+			current: name == activeJournal,
+		})
+	}
+
+	m.journalPicker = m.styles.newList(items, m.width-4, m.height-8, "📓 Journals - Press Enter to switch")
+	m.mode = journalPickerView
+	m.statusMsg = "Create more journals with: notetype journal create <name>"
+
+	return m, nil
+}
+
+// Switch to another journal and browse its entries
+func (m model) switchJournal(name string) (tea.Model, tea.Cmd) {
+	if err := setActiveJournal(name); err != nil {
+		m.statusMsg = "Error: " + err.Error()
 		return m, nil
 	}
+	return m.loadJournals()
+}
 
-	var items []list.Item
+// Notes are shown as a tree of notebooks, expanded as they were left
+func (m model) loadNotes() (tea.Model, tea.Cmd) {
+	files, notebooks := scanNotes("")
+
+	var notes []noteItem
+	journals := loadJournalConfigs()
 	for _, file := range files {
-		info, _ := os.Stat(file)
-		name := strings.TrimSuffix(filepath.Base(file), ".md")
-		items = append(items, noteItem{
-			filename: name,
-			title:    name,
-			date:     info.ModTime().Format("Jan 2, 2006 15:04"),
-			size:     formatSizeInTUI(info.Size()),
-		})
+		name := noteLinkName(journals, file)
+		item, err := newNoteItem(journals, file, name, strings.TrimSuffix(filepath.Base(file), ".md"))
+		if err != nil || !m.noteFilter.matches(item) {
+			continue
+		}
+		item.depth = strings.Count(name, "/")
+		notes = append(notes, item)
+	}
+	mode := listSortMode("notes")
+	sortNoteItems(notes, mode)
+
+	// While filtering, show every matching note and only the notebooks they are in
+	expanded := m.openFolders
+	if !m.noteFilter.isEmpty() {
+		notebooks = nil
+		expanded = make(map[string]bool)
+		for _, note := range notes {
+			for dir := noteNotebook(note.path); dir != ""; dir = noteNotebook(dir) {
+				expanded[dir] = true
+			}
+		}
 	}
 
-	m.notesList = list.New(items, list.NewDefaultDelegate(), m.width-4, m.height-8)
-	m.notesList.Title = "📝 Notes"
-	m.notesList.Styles.Title = titleStyle
+	m.notesList = m.styles.newList(noteTree(notes, notebooks, expanded), m.width-4, m.listHeight(m.noteFilter), "📝 Notes · by "+mode)
 	m.mode = listView
 	m.isJournal = false
-	m.statusMsg = fmt.Sprintf("Found %d notes", len(items))
+	m.statusMsg = fmt.Sprintf("Found %d notes in %d notebooks", len(notes), len(notebooks))
+	if !m.noteFilter.isEmpty() {
+		m.statusMsg = fmt.Sprintf("%d of %d notes match", len(notes), len(files))
+	}
 
 	return m, nil
 }
@@ -952,6 +1559,7 @@ func (m model) createNewNote() (tea.Model, tea.Cmd) {
 	m.mode = editorView
 	m.isJournal = false
 	m.currentNote = ""
+	m.currentEntry = 0
 	m.editor.SetValue("")
 	m.statusMsg = "Creating new note"
 	return m, textarea.Blink
@@ -961,27 +1569,149 @@ func (m model) createNewEntry() (tea.Model, tea.Cmd) {
 	if m.isJournal {
 		return m.openTodayJournal()
 	}
-	return m.createNewNote()
+
+	// New notes go in the notebook being browsed
+	notebook := ""
+	switch item := m.notesList.SelectedItem().(type) {
+	case folderItem:
+		notebook = item.path
+	case noteItem:
+		notebook = noteNotebook(item.path)
+	}
+
+	next, cmd := m.createNewNote()
+	if nm, ok := next.(model); ok && notebook != "" {
+		nm.currentNote = notebook + "/" + fmt.Sprintf("note-%d", time.Now().Unix())
+		nm.statusMsg = "Creating new note in " + notebook
+		return nm, cmd
+	}
+	return next, cmd
 }
 
 func (m model) openJournal(filename string) (tea.Model, tea.Cmd) {
-	journalDir := getJournalDir()
-	filePath := filepath.Join(journalDir, filename+".md")
-
-	content, err := os.ReadFile(filePath)
+	entries, err := loadJournalEntries(filename)
 	if err != nil {
 		m.statusMsg = "Error opening journal: " + err.Error()
 		return m, nil
 	}
+	trackRecent(getJournalEntryPath(filename))
 
-	m.mode = viewerView
+	// Days without timestamped blocks open straight away
+	if len(entries) == 0 {
+		return m.openJournalEntry(filename, 0)
+	}
+
+	items := []list.Item{entryItem{date: filename}}
+	for _, entry := range entries {
+		items = append(items, entryItem{
+			date:    filename,
+			index:   entry.index,
+			time:    entry.time,
+			preview: entryPreview(entry, 60),
+			tags:    entry.tags,
+		})
+	}
+
+	m.entriesList = m.styles.newList(items, m.width-4, m.height-8, "📔 "+filename+" - Press Enter to read, e to edit")
+	m.mode = entriesView
 	m.currentNote = filename
 	m.isJournal = true
-	m.viewer.SetContent(string(content))
+	m.statusMsg = fmt.Sprintf("%d entries on %s", len(entries), filename)
+	return m, nil
+}
+
+// openJournalEntry shows a single timestamped block, or the whole day for index 0
+func (m model) openJournalEntry(date string, index int) (tea.Model, tea.Cmd) {
+	var content string
+	if index == 0 {
+		data, err := os.ReadFile(getJournalEntryPath(date))
+		if err != nil {
+			m.statusMsg = "Error opening journal: " + err.Error()
+			return m, nil
+		}
+		content = string(data)
+	} else {
+		entry, err := getJournalEntry(date, index)
+		if err != nil {
+			m.statusMsg = "Error opening entry: " + err.Error()
+			return m, nil
+		}
+		content = fmt.Sprintf("### %s\n\n%s", entry.time, entry.body)
+	}
+
+	m.mode = viewerView
+	m.currentNote = date
+	m.currentEntry = index
+	m.isJournal = true
+	m.viewer.SetContent(m.styles.renderMarkdown(content))
 	m.statusMsg = "Viewing journal entry - Press 'e' to edit"
 	return m, nil
 }
 
+// confirmation is an action waiting for Enter or y before it runs
+type confirmation struct {
+	title string
+	lines []string // what the action will change
+	from  viewMode // view to return to
+	yes   func(m model) (tea.Model, tea.Cmd)
+}
+
+// Ask before running an action that can't be undone
+func (m model) askConfirm(title string, lines []string, yes func(m model) (tea.Model, tea.Cmd)) (tea.Model, tea.Cmd) {
+	m.confirm = confirmation{title: title, lines: lines, from: m.mode, yes: yes}
+	m.mode = confirmView
+	m.statusMsg = "Enter or y to confirm, Esc to cancel"
+	return m, nil
+}
+
+// Render the action waiting for confirmation
+func (m model) renderConfirm() string {
+	lines := m.confirm.lines
+	if room := m.height - 14; room > 0 && len(lines) > room {
+		lines = append(lines[:room-1:room-1], fmt.Sprintf("… and %d more", len(m.confirm.lines)-room+1))
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.styles.Header.Render(m.confirm.title),
+		m.styles.Dialog.Width(m.width-4).Render(strings.Join(lines, "\n")),
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.styles.ActiveButton.Render("↵ Confirm (Enter/y)"),
+			m.styles.InactiveButton.Render("Cancel (Esc)"),
+		),
+	)
+}
+
+// Ask before deleting the highlighted journal entry
+func (m model) confirmDeleteEntry() (tea.Model, tea.Cmd) {
+	item, ok := m.entriesList.SelectedItem().(entryItem)
+	if !ok || item.index == 0 {
+		m.statusMsg = "Select a single entry to delete"
+		return m, nil
+	}
+
+	title := fmt.Sprintf("🗑️  Delete entry %d of %s?", item.index, item.date)
+	return m.askConfirm(title, []string{item.Title()}, func(m model) (tea.Model, tea.Cmd) {
+		return m.deleteEntry(item)
+	})
+}
+
+// Delete a single journal entry and reopen its day
+func (m model) deleteEntry(item entryItem) (tea.Model, tea.Cmd) {
+	if err := deleteJournalEntry(item.date, item.index); err != nil {
+		m.statusMsg = "Error deleting: " + err.Error()
+		return m, nil
+	}
+
+	next, cmd := m.openJournal(item.date)
+	if nm, ok := next.(model); ok {
+		nm.statusMsg = fmt.Sprintf("✅ Deleted entry %d", item.index)
+		return nm, cmd
+	}
+	return next, cmd
+}
+
 func (m model) openNote(filename string) (tea.Model, tea.Cmd) {
 	filePath := filename + ".md"
 
@@ -991,15 +1721,31 @@ func (m model) openNote(filename string) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	trackRecent(filePath)
 	m.mode = viewerView
 	m.currentNote = filename
+	m.currentEntry = 0
 	m.isJournal = false
-	m.viewer.SetContent(string(content))
+	m.viewer.SetContent(m.styles.renderMarkdown(string(content)))
 	m.statusMsg = "Viewing note - Press 'e' to edit"
 	return m, nil
 }
 
 func (m model) editCurrentNote() (tea.Model, tea.Cmd) {
+	// A single journal entry is edited on its own
+	if m.isJournal && m.currentEntry > 0 {
+		entry, err := getJournalEntry(m.currentNote, m.currentEntry)
+		if err != nil {
+			m.statusMsg = "Error loading entry for editing: " + err.Error()
+			return m, nil
+		}
+
+		m.mode = editorView
+		m.editor.SetValue(entry.body)
+		m.statusMsg = "Editing entry - Press Ctrl+S to save, Esc to cancel"
+		return m, textarea.Blink
+	}
+
 	// Load current content into editor
 	var filePath string
 	if m.isJournal {
@@ -1024,8 +1770,18 @@ func (m model) editCurrentNote() (tea.Model, tea.Cmd) {
 
 func (m model) saveCurrentNote() (tea.Model, tea.Cmd) {
 	content := m.editor.Value()
+	// Tags and notes added by this save show up in later suggestions
+	m = m.resetCompletions()
 
-	if m.isJournal {
+	if m.isJournal && m.currentEntry > 0 {
+		if err := updateJournalEntry(m.currentNote, m.currentEntry, content); err != nil {
+			m.statusMsg = "Error saving entry: " + err.Error()
+			return m, nil
+		}
+		trackRecent(getJournalEntryPath(m.currentNote))
+
+		m.statusMsg = "✅ Entry saved successfully! Press Esc to go back"
+	} else if m.isJournal {
 		// Save to journal directory
 		if err := ensureJournalDir(); err != nil {
 			m.statusMsg = "Error: " + err.Error()
@@ -1043,6 +1799,8 @@ func (m model) saveCurrentNote() (tea.Model, tea.Cmd) {
 			m.statusMsg = "Error saving journal: " + err.Error()
 			return m, nil
 		}
+		m.currentNote = filename
+		trackRecent(filePath)
 
 		m.statusMsg = "✅ Journal saved successfully! Press Esc to go back"
 	} else {
@@ -1053,10 +1811,19 @@ func (m model) saveCurrentNote() (tea.Model, tea.Cmd) {
 		}
 		filePath := filename + ".md"
 
-		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		var err error
+		if m.newFile {
+			err = createNoteFile(filePath, content)
+		} else {
+			err = os.WriteFile(filePath, []byte(content), 0644)
+		}
+		if err != nil {
 			m.statusMsg = "Error saving note: " + err.Error()
 			return m, nil
 		}
+		m.newFile = false
+		m.currentNote = filename
+		trackRecent(filePath)
 
 		m.statusMsg = "✅ Note saved successfully! Press Esc to go back"
 	}
@@ -1065,6 +1832,10 @@ func (m model) saveCurrentNote() (tea.Model, tea.Cmd) {
 }
 
 func (m model) deleteSelected() (tea.Model, tea.Cmd) {
+	if marked := markedNoteItems(*m.currentList()); len(marked) > 0 {
+		return m.confirmDeleteMarked(marked)
+	}
+
 	var filePath string
 
 	if m.isJournal {
@@ -1093,6 +1864,259 @@ func (m model) deleteSelected() (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// currentList returns the notes or journals list shown in listView
+func (m *model) currentList() *list.Model {
+	if m.isJournal {
+		return &m.journalsList
+	}
+	return &m.notesList
+}
+
+// markListItem toggles the mark on the highlighted note or tag and moves on
+func markListItem(l *list.Model) {
+	switch item := l.SelectedItem().(type) {
+	case noteItem:
+		item.marked = !item.marked
+		l.SetItem(l.GlobalIndex(), item)
+	case tagItem:
+		item.marked = !item.marked
+		l.SetItem(l.GlobalIndex(), item)
+	default:
+		return
+	}
+	l.CursorDown()
+}
+
+// markedNoteItems returns the marked notes of a list
+func markedNoteItems(l list.Model) []noteItem {
+	var marked []noteItem
+	for _, item := range l.Items() {
+		if note, ok := item.(noteItem); ok && note.marked {
+			marked = append(marked, note)
+		}
+	}
+	return marked
+}
+
+// targetNoteItems returns the marked notes, or the highlighted one
+func targetNoteItems(l list.Model) []noteItem {
+	if marked := markedNoteItems(l); len(marked) > 0 {
+		return marked
+	}
+	if item, ok := l.SelectedItem().(noteItem); ok {
+		return []noteItem{item}
+	}
+	return nil
+}
+
+// Ask for the tag to add to or remove from the marked notes
+func (m model) startBulkTag(remove bool) (tea.Model, tea.Cmd) {
+	targets := targetNoteItems(*m.currentList())
+	if len(targets) == 0 {
+		m.statusMsg = "Nothing to tag"
+		return m, nil
+	}
+
+	m.bulkPaths = nil
+	for _, item := range targets {
+		m.bulkPaths = append(m.bulkPaths, item.path)
+	}
+	m.bulkRemove = remove
+	m.bulkInput = textinput.New()
+	m.bulkInput.Prompt = "#"
+	m.bulkInput.Placeholder = "tag"
+	m.bulkInput.Width = m.width - 10
+	m.styles.styleInput(&m.bulkInput)
+	m.bulkInput.Focus()
+	m.mode = bulkTagView
+	m.statusMsg = "Enter to apply, Esc to cancel"
+	return m, textinput.Blink
+}
+
+// Add or remove the entered tag on every marked note
+func (m model) applyBulkTag(tag string) (tea.Model, tea.Cmd) {
+	tag = normalizeTag(tag)
+	if !tagNameRe.MatchString(tag) {
+		m.statusMsg = "Tags may only contain letters, digits, '-', '_' and '/'"
+		return m, nil
+	}
+
+	edits, err := planTagEdits(m.bulkPaths, tag, m.bulkRemove, func(string) bool { return true })
+	m.mode = listView
+	if err != nil {
+		m.statusMsg = "Error: " + err.Error()
+		return m, nil
+	}
+
+	if m.bulkRemove {
+		done := fmt.Sprintf("✅ Removed #%s from %d of %d note(s)", tag, len(edits), len(m.bulkPaths))
+		return m.previewTagEdits("Remove #"+tag, edits, done)
+	}
+	done := fmt.Sprintf("✅ Added #%s to %d of %d note(s)", tag, len(edits), len(m.bulkPaths))
+	return m.previewTagEdits("Add #"+tag, edits, done)
+}
+
+func (m model) renderBulkTag() string {
+	headerText := fmt.Sprintf("🏷️  Add a tag to %d note(s)", len(m.bulkPaths))
+	if m.bulkRemove {
+		headerText = fmt.Sprintf("🏷️  Remove a tag from %d note(s)", len(m.bulkPaths))
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.styles.Header.Render(headerText),
+		m.styles.Dialog.Width(m.width-4).Render(m.bulkInput.View()),
+		m.styles.ActiveButton.Render("↵ Apply (Enter)"),
+	)
+}
+
+// Ask for the new name of the highlighted note or journal day
+func (m model) startRename() (tea.Model, tea.Cmd) {
+	item, ok := m.currentList().SelectedItem().(noteItem)
+	if !ok {
+		m.statusMsg = "Nothing to rename"
+		return m, nil
+	}
+
+	name := strings.TrimSuffix(item.path, ".md")
+	if journal, ok := loadJournalConfigs().journalFor(item.path); ok {
+		name = "journal:" + journal + "/" + item.filename
+	}
+
+	m.renameFrom = item.path
+	m.folderMove = false
+	m.renameInput = textinput.New()
+	m.renameInput.Placeholder = "new name, folder/name or journal:<journal>/<date>"
+	m.renameInput.SetValue(name)
+	m.renameInput.Width = m.width - 10
+	m.styles.styleInput(&m.renameInput)
+	m.renameInput.Focus()
+	m.mode = renameView
+	m.statusMsg = "Enter to rename, Ctrl+T to rename and retitle, Esc to cancel"
+	return m, textinput.Blink
+}
+
+// Move the note to the entered name, updating links to it
+func (m model) applyRename(retitle bool) (tea.Model, tea.Cmd) {
+	if m.folderMove {
+		return m.applyMoveToFolder()
+	}
+
+	to, err := resolveNotePath(strings.TrimSpace(m.renameInput.Value()))
+	if err != nil {
+		m.statusMsg = "Error: " + err.Error()
+		return m, nil
+	}
+
+	result, err := moveNote(m.renameFrom, to, moveOptions{retitle: retitle, links: true})
+	if err != nil {
+		m.statusMsg = "Error: " + err.Error()
+		return m, nil
+	}
+
+	var next tea.Model
+	if m.isJournal {
+		next, _ = m.loadJournals()
+	} else {
+		next, _ = m.loadNotes()
+	}
+	nm := next.(model)
+	nm.statusMsg = fmt.Sprintf("✅ Moved to %s", to)
+	if result.links > 0 {
+		nm.statusMsg += fmt.Sprintf(" • %d link(s) updated", result.links)
+	}
+	return nm, nil
+}
+
+func (m model) renderRename() string {
+	if m.folderMove {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			m.styles.Header.Render(fmt.Sprintf("📁 Move %d note(s) to a notebook", len(m.bulkPaths))),
+			m.styles.Dialog.Width(m.width-4).Render(m.renameInput.View()),
+			m.styles.ActiveButton.Render("↵ Move (Enter)"),
+		)
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.styles.Header.Render("✏️  Rename "+m.renameFrom),
+		m.styles.Dialog.Width(m.width-4).Render(m.renameInput.View()),
+		lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.styles.ActiveButton.Render("↵ Rename (Enter)"),
+			m.styles.InactiveButton.Render("✏️  Rename and retitle (Ctrl+T)"),
+		),
+	)
+}
+
+// Ask before deleting every marked note, listing them
+func (m model) confirmDeleteMarked(marked []noteItem) (tea.Model, tea.Cmd) {
+	var lines []string
+	for _, item := range marked {
+		lines = append(lines, "📄 "+item.path)
+	}
+
+	title := fmt.Sprintf("🗑️  Delete %d marked item(s)?", len(marked))
+	return m.askConfirm(title, lines, func(m model) (tea.Model, tea.Cmd) {
+		return m.deleteMarked(marked)
+	})
+}
+
+// Delete every marked note
+func (m model) deleteMarked(marked []noteItem) (tea.Model, tea.Cmd) {
+	deleted := 0
+	for _, item := range marked {
+		if err := os.Remove(item.path); err != nil {
+			m.statusMsg = "Error deleting: " + err.Error()
+			return m, nil
+		}
+		deleted++
+	}
+
+	var next tea.Model
+	if m.isJournal {
+		next, _ = m.loadJournals()
+	} else {
+		next, _ = m.loadNotes()
+	}
+	nm := next.(model)
+	nm.statusMsg = fmt.Sprintf("✅ Deleted %d item(s)", deleted)
+	return nm, nil
+}
+
+// Strip the marked tags, or the highlighted one, from every note and journal
+func (m model) removeMarkedTags() (tea.Model, tea.Cmd) {
+	var tags []string
+	for _, item := range m.tagsList.Items() {
+		if t, ok := item.(tagItem); ok && t.marked {
+			tags = append(tags, t.tag)
+		}
+	}
+	if len(tags) == 0 {
+		if t, ok := m.tagsList.SelectedItem().(tagItem); ok {
+			tags = append(tags, t.tag)
+		}
+	}
+
+	if len(tags) == 0 {
+		return m, nil
+	}
+
+	edits, err := planTagRemovals(taggedFiles(), tags)
+	if err != nil {
+		m.statusMsg = "Error: " + err.Error()
+		return m, nil
+	}
+
+	removed := 0
+	for _, edit := range edits {
+		removed += edit.changes
+	}
+	done := fmt.Sprintf("✅ Removed %d tag(s) from %d file(s)", removed, len(edits))
+	return m.previewTagEdits("Remove #"+strings.Join(tags, " #"), edits, done)
+}
+
 // TUI command (kept for backwards compatibility, but TUI is now default)
 var tuiCmd = &cobra.Command{
 	Use:   "tui",
@@ -3,6 +3,7 @@ package cmd
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -10,21 +11,89 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// updateFile appends content to an existing file
-func updateFile(filename string, content string, interactive bool, addTimestamp bool) error {
+// readMultilineInput reads lines until Ctrl+D or a line containing only 'EOF'
+func readMultilineInput(in io.Reader) string {
+	reader := bufio.NewReader(in)
+	var lines []string
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// EOF reached
+			break
+		}
+
+		// Check if user typed EOF
+		trimmedLine := strings.TrimSpace(line)
+		if trimmedLine == "EOF" || trimmedLine == "eof" {
+			break
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "")
+}
+
+// updateOptions controls where 'notetype update' puts new content
+type updateOptions struct {
+	under          string // insert at the end of this section
+	replaceSection string // replace the body of this section
+	prepend        bool   // insert at the top of the note, or of the --under section
+	checkbox       bool   // turn each line into a "- [ ]" item
+	timestamp      bool
+}
+
+// formatUpdate applies --checkbox to the new content
+func formatUpdate(content string, opts updateOptions) string {
+	content = strings.TrimRight(content, "\n")
+	if !opts.checkbox {
+		return content
+	}
+
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			continue
+		case listItemKind(trimmed) == "- [ ]":
+			lines = append(lines, trimmed)
+		case listItemKind(trimmed) == "-":
+			lines = append(lines, "- [ ] "+strings.TrimSpace(trimmed[1:]))
+		default:
+			lines = append(lines, "- [ ] "+trimmed)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// updateFile adds content to an existing file: appended at the end by
+// default, or placed in a section or at the top as opts asks
+func updateFile(filename string, content string, interactive bool, opts updateOptions) error {
 	filepath := filename + ".md"
 
 	// Check if file exists
-	if _, err := os.Stat(filepath); os.IsNotExist(err) {
+	info, err := os.Stat(filepath)
+	if os.IsNotExist(err) {
 		return fmt.Errorf("file '%s' does not exist. Use 'notetype new' to create it first", filepath)
 	}
-
-	// Open file with proper flags and permissions
-	file, err := os.OpenFile(filepath, os.O_APPEND|os.O_WRONLY, 0644)
 	if err != nil {
 		return fmt.Errorf("error opening file: %v", err)
 	}
-	defer file.Close()
+
+	existing, err := os.ReadFile(filepath)
+	if err != nil {
+		return fmt.Errorf("error reading file: %v", err)
+	}
+
+	// Check the section exists before asking for any input
+	lines := strings.Split(string(existing), "\n")
+	for _, name := range []string{opts.under, opts.replaceSection} {
+		if _, ok := findSection(lines, name); name != "" && !ok {
+			return sectionNotFoundError(lines, name)
+		}
+	}
 
 	var fullContent string
 
@@ -33,43 +102,40 @@ func updateFile(filename string, content string, interactive bool, addTimestamp
 		fmt.Println("\n✍️  Enter your update (press Ctrl+D or type 'EOF' on a new line to finish):")
 		fmt.Println(strings.Repeat("-", 70))
 
-		reader := bufio.NewReader(os.Stdin)
-		var lines []string
-
-		for {
-			line, err := reader.ReadString('\n')
-			if err != nil {
-				// EOF reached
-				break
-			}
-
-			// Check if user typed EOF
-			trimmedLine := strings.TrimSpace(line)
-			if trimmedLine == "EOF" || trimmedLine == "eof" {
-				break
-			}
-
-			lines = append(lines, line)
-		}
-
-		fullContent = strings.Join(lines, "")
+		fullContent = readMultilineInput(os.Stdin)
 		fmt.Println(strings.Repeat("-", 70))
 	} else {
 		fullContent = content
 	}
 
-	// Add timestamp if requested
-	var updateText string
-	if addTimestamp {
+	fullContent = formatUpdate(fullContent, opts)
+
+	// Add timestamp if requested. Only an update appended at the end of the
+	// note gets a rule above it; inside a section it would split the content.
+	if opts.timestamp {
 		timestamp := time.Now().Format("2006-01-02 15:04:05")
-		updateText = fmt.Sprintf("\n\n---\n**Updated:** %s\n\n%s", timestamp, fullContent)
-	} else {
-		updateText = "\n\n" + fullContent
+		fullContent = fmt.Sprintf("**Updated:** %s\n\n%s", timestamp, fullContent)
+		if opts.under == "" && opts.replaceSection == "" && !opts.prepend {
+			fullContent = "---\n" + fullContent
+		}
 	}
 
-	// Append content with proper formatting
-	_, err = file.WriteString(updateText)
+	var updated string
+	switch {
+	case opts.replaceSection != "":
+		updated, err = replaceSectionBody(string(existing), opts.replaceSection, fullContent)
+	case opts.under != "":
+		updated, err = insertIntoSection(string(existing), opts.under, fullContent, opts.prepend)
+	case opts.prepend:
+		updated = prependToNote(string(existing), fullContent)
+	default:
+		updated = string(existing) + "\n\n" + fullContent
+	}
 	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath, []byte(updated), info.Mode().Perm()); err != nil {
 		return fmt.Errorf("error writing to file: %v", err)
 	}
 
@@ -87,6 +153,13 @@ var updateCmd = &cobra.Command{
 This is useful when you want to add more information to a note without
 overwriting the existing content. You can use interactive mode for multi-line updates.
 
+By default content goes at the end of the note. Use --under to add it at
+the end of a Markdown section instead, --prepend to add it at the top,
+or --replace-section to swap out a section's content. Headings match
+without case, emoji or trailing colons, so "action items" finds
+"## ✅ Action Items:". With --checkbox every line becomes a "- [ ]" item,
+filling a template's empty "- [ ]" placeholders first.
+
 Examples:
   # Interactive mode (for multi-paragraph updates)
   notetype update daily-log -I
@@ -99,6 +172,16 @@ Examples:
   
   # Update today's entry
   notetype update $(date +%Y-%m-%d) "Evening reflection"
+
+  # Add an action item to a meeting note
+  notetype update meeting-sprint --under "Action Items" --checkbox "Send the recap"
+
+  # Add to the top of a note, or of a section
+  notetype update ideas --prepend "Newest idea first"
+  notetype update project-x --under "Notes" --prepend "Kickoff moved to Monday"
+
+  # Rewrite a section
+  notetype update project-x --replace-section "Status" "On track"
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		filename := args[0]
@@ -109,14 +192,28 @@ Examples:
 		}
 
 		interactive, _ := cmd.Flags().GetBool("interactive")
-		addTimestamp, _ := cmd.Flags().GetBool("timestamp")
+		var opts updateOptions
+		opts.timestamp, _ = cmd.Flags().GetBool("timestamp")
+		opts.under, _ = cmd.Flags().GetString("under")
+		opts.replaceSection, _ = cmd.Flags().GetString("replace-section")
+		opts.prepend, _ = cmd.Flags().GetBool("prepend")
+		opts.checkbox, _ = cmd.Flags().GetBool("checkbox")
+
+		if opts.under != "" && opts.replaceSection != "" {
+			fmt.Println("❌ Use either --under or --replace-section, not both")
+			os.Exit(1)
+		}
+		if opts.prepend && opts.replaceSection != "" {
+			fmt.Println("❌ --prepend can't be combined with --replace-section")
+			os.Exit(1)
+		}
 
 		// If no content provided and not interactive, enable interactive mode
 		if content == "" && !interactive {
 			interactive = true
 		}
 
-		if err := updateFile(filename, content, interactive, addTimestamp); err != nil {
+		if err := updateFile(filename, content, interactive, opts); err != nil {
 			fmt.Printf("❌ %v\n", err)
 			os.Exit(1)
 		}
@@ -126,5 +223,9 @@ Examples:
 func init() {
 	updateCmd.Flags().BoolP("interactive", "I", false, "Enter interactive mode for multi-line input")
 	updateCmd.Flags().BoolP("timestamp", "t", false, "Add timestamp to the update")
+	updateCmd.Flags().StringP("under", "u", "", "Add the content at the end of this section")
+	updateCmd.Flags().String("replace-section", "", "Replace the content of this section")
+	updateCmd.Flags().BoolP("prepend", "p", false, "Add the content at the top of the note (or of the --under section)")
+	updateCmd.Flags().BoolP("checkbox", "c", false, `Add each line as a "- [ ]" item`)
 	rootCmd.AddCommand(updateCmd)
 }